@@ -22,6 +22,8 @@ import (
 	"open-match.dev/open-match/internal/rpc"
 	"open-match.dev/open-match/internal/statestore"
 	"open-match.dev/open-match/internal/telemetry"
+	"open-match.dev/open-match/internal/waittime"
+	"open-match.dev/open-match/internal/webhook"
 	"open-match.dev/open-match/pkg/pb"
 )
 
@@ -73,10 +75,19 @@ var (
 
 // BindService creates the backend service and binds it to the serving harness.
 func BindService(p *appmain.Params, b *appmain.Bindings) error {
+	webhooks, err := webhook.New(p.Config())
+	if err != nil {
+		return err
+	}
+	b.AddCloser(webhooks.Close)
+
+	store := statestore.New(p.Config())
 	service := &backendService{
 		synchronizer: newSynchronizerClient(p.Config()),
-		store:        statestore.New(p.Config()),
+		store:        store,
 		cc:           rpc.NewClientCache(p.Config()),
+		webhooks:     webhooks,
+		waitTimes:    waittime.New(p.Config(), store),
 	}
 
 	b.AddHealthCheckFunc(service.store.HealthCheck)
@@ -40,6 +40,8 @@ import (
 	"open-match.dev/open-match/internal/ipb"
 	"open-match.dev/open-match/internal/rpc"
 	"open-match.dev/open-match/internal/statestore"
+	"open-match.dev/open-match/internal/waittime"
+	"open-match.dev/open-match/internal/webhook"
 	"open-match.dev/open-match/pkg/pb"
 )
 
@@ -49,6 +51,8 @@ type backendService struct {
 	synchronizer *synchronizerClient
 	store        statestore.Service
 	cc           *rpc.ClientCache
+	webhooks     *webhook.Notifier
+	waitTimes    *waittime.Estimator
 }
 
 var (
@@ -59,10 +63,17 @@ var (
 	errBackfillGenerationMismatch = errors.New("backfill generation mismatch")
 )
 
+const (
+	// defaultListMatchesPageSize and maxListMatchesPageSize bound the number of matches returned by ListMatches.
+	defaultListMatchesPageSize = 100
+	maxListMatchesPageSize     = 1000
+)
+
 // FetchMatches triggers a MatchFunction with the specified MatchProfiles, while each MatchProfile
 // returns a set of match proposals. FetchMatches method streams the results back to the caller.
 // FetchMatches immediately returns an error if it encounters any execution failures.
 //   - If the synchronizer is enabled, FetchMatch will then call the synchronizer to deduplicate proposals with overlapped tickets.
+//   - If DryRun is set, the returned matches are marked as non binding, and neither their tickets nor backfills are persisted.
 func (s *backendService) FetchMatches(req *pb.FetchMatchesRequest, stream pb.BackendService_FetchMatchesServer) error {
 	if req.Config == nil {
 		return status.Error(codes.InvalidArgument, ".config is required")
@@ -70,10 +81,16 @@ func (s *backendService) FetchMatches(req *pb.FetchMatchesRequest, stream pb.Bac
 	if req.Profile == nil {
 		return status.Error(codes.InvalidArgument, ".profile is required")
 	}
+	if req.Profile.PendingReleaseTimeout != nil {
+		d, err := ptypes.Duration(req.Profile.PendingReleaseTimeout)
+		if err != nil || d <= 0 {
+			return status.Error(codes.InvalidArgument, ".profile.pending_release_timeout must be positive")
+		}
+	}
 
 	// Error group for handling the synchronizer calls only.
 	eg, ctx := errgroup.WithContext(stream.Context())
-	syncStream, err := s.synchronizer.synchronize(ctx)
+	syncStream, err := s.synchronizer.synchronize(ctx, req.GetProfile().GetSynchronizationPartition())
 	if err != nil {
 		return err
 	}
@@ -88,10 +105,10 @@ func (s *backendService) FetchMatches(req *pb.FetchMatchesRequest, stream pb.Bac
 	m := &sync.Map{}
 
 	eg.Go(func() error {
-		return synchronizeSend(ctx, syncStream, m, proposals)
+		return synchronizeSend(ctx, syncStream, m, proposals, req)
 	})
 	eg.Go(func() error {
-		return synchronizeRecv(ctx, syncStream, m, stream, startMmfs, cancelMmfs, s.store)
+		return synchronizeRecv(ctx, syncStream, m, stream, startMmfs, cancelMmfs, s.store, req.GetDryRun())
 	})
 
 	var mmfErr error
@@ -116,7 +133,7 @@ func (s *backendService) FetchMatches(req *pb.FetchMatchesRequest, stream pb.Bac
 	return nil
 }
 
-func synchronizeSend(ctx context.Context, syncStream synchronizerStream, m *sync.Map, proposals <-chan *pb.Match) error {
+func synchronizeSend(ctx context.Context, syncStream synchronizerStream, m *sync.Map, proposals <-chan *pb.Match, req *pb.FetchMatchesRequest) error {
 sendProposals:
 	for {
 		select {
@@ -130,7 +147,11 @@ sendProposals:
 			if loaded {
 				return fmt.Errorf("MatchMakingFunction returned same match_id twice: \"%s\"", p.GetMatchId())
 			}
-			err := syncStream.Send(&ipb.SynchronizeRequest{Proposal: p})
+			err := syncStream.Send(&ipb.SynchronizeRequest{
+				Proposal:              p,
+				DryRun:                req.GetDryRun(),
+				PendingReleaseTimeout: req.GetProfile().GetPendingReleaseTimeout(),
+			})
 			if err != nil {
 				return fmt.Errorf("error sending proposal to synchronizer: %w", err)
 			}
@@ -144,7 +165,7 @@ sendProposals:
 	return nil
 }
 
-func synchronizeRecv(ctx context.Context, syncStream synchronizerStream, m *sync.Map, stream pb.BackendService_FetchMatchesServer, startMmfs chan<- struct{}, cancelMmfs contextcause.CancelErrFunc, store statestore.Service) error {
+func synchronizeRecv(ctx context.Context, syncStream synchronizerStream, m *sync.Map, stream pb.BackendService_FetchMatchesServer, startMmfs chan<- struct{}, cancelMmfs contextcause.CancelErrFunc, store statestore.Service, dryRun bool) error {
 	var startMmfsOnce sync.Once
 
 	for {
@@ -166,14 +187,23 @@ func synchronizeRecv(ctx context.Context, syncStream synchronizerStream, m *sync
 			cancelMmfs(errors.New("match function ran longer than proposal window, canceling"))
 		}
 
+		if id := resp.GetQuotaRejectedMatchId(); id != "" {
+			err = stream.Send(&pb.FetchMatchesResponse{QuotaRejectedMatchId: id})
+			if err != nil {
+				return fmt.Errorf("error sending quota rejected match to caller of backend: %w", err)
+			}
+		}
+
 		if v, ok := m.Load(resp.GetMatchId()); ok {
 			match, ok := v.(*pb.Match)
 			if !ok {
 				return fmt.Errorf("error casting sync map value into *pb.Match: %w", err)
 			}
 
+			// Dry run matches are not binding, so their backfills must not be
+			// created or updated.
 			backfill := match.GetBackfill()
-			if backfill != nil {
+			if backfill != nil && !dryRun {
 				ticketIds := make([]string, 0, len(match.Tickets))
 
 				for _, t := range match.Tickets {
@@ -196,9 +226,17 @@ func synchronizeRecv(ctx context.Context, syncStream synchronizerStream, m *sync
 				}
 			}
 
+			if !dryRun {
+				err = createMatchRecord(ctx, match, store)
+				// Match records are informational only, so failing to persist one does not fail the stream.
+				if err != nil {
+					logger.WithError(err).Errorf("failed to persist match record %s", match.MatchId)
+				}
+			}
+
 			stats.Record(ctx, totalBytesPerMatch.M(int64(proto.Size(match))))
 			stats.Record(ctx, ticketsPerMatch.M(int64(len(match.GetTickets()))))
-			err = stream.Send(&pb.FetchMatchesResponse{Match: match})
+			err = stream.Send(&pb.FetchMatchesResponse{Match: match, NonBinding: dryRun})
 			if err != nil {
 				return fmt.Errorf("error sending match to caller of backend: %w", err)
 			}
@@ -342,6 +380,39 @@ func doReleaseTickets(ctx context.Context, ticketIds []string, store statestore.
 	return nil
 }
 
+// ExtendPendingRelease postpones the release of pending tickets back to the pool, either listed explicitly or
+// by the match they were returned in.
+func (s *backendService) ExtendPendingRelease(ctx context.Context, req *pb.ExtendPendingReleaseRequest) (*pb.ExtendPendingReleaseResponse, error) {
+	if len(req.GetTicketIds()) == 0 && req.GetMatchId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ".ticket_ids or .match_id is required")
+	}
+
+	var timeout time.Duration
+	if req.GetTimeout() != nil {
+		var err error
+		timeout, err = ptypes.Duration(req.GetTimeout())
+		if err != nil || timeout <= 0 {
+			return nil, status.Error(codes.InvalidArgument, ".timeout must be positive")
+		}
+	}
+
+	ids := append([]string{}, req.GetTicketIds()...)
+	if req.GetMatchId() != "" {
+		record, err := s.store.GetMatchRecord(ctx, req.GetMatchId())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, record.GetTicketIds()...)
+	}
+
+	notPending, err := s.store.ExtendPendingRelease(ctx, ids, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExtendPendingReleaseResponse{NotPendingTicketIds: notPending}, nil
+}
+
 func (s *backendService) ReleaseAllTickets(ctx context.Context, req *pb.ReleaseAllTicketsRequest) (*pb.ReleaseAllTicketsResponse, error) {
 	err := s.store.ReleaseAllTickets(ctx)
 	if err != nil {
@@ -350,9 +421,10 @@ func (s *backendService) ReleaseAllTickets(ctx context.Context, req *pb.ReleaseA
 	return &pb.ReleaseAllTicketsResponse{}, nil
 }
 
-// AssignTickets overwrites the Assignment field of the input TicketIds.
+// AssignTickets sets the Assignment field of the input TicketIds.
+// Tickets which already have an Assignment are only assigned again if overwrite is set.
 func (s *backendService) AssignTickets(ctx context.Context, req *pb.AssignTicketsRequest) (*pb.AssignTicketsResponse, error) {
-	resp, err := doAssignTickets(ctx, req, s.store)
+	resp, err := doAssignTickets(ctx, req, s.store, s.webhooks, s.waitTimes)
 	if err != nil {
 		return nil, err
 	}
@@ -366,6 +438,98 @@ func (s *backendService) AssignTickets(ctx context.Context, req *pb.AssignTicket
 	return resp, nil
 }
 
+// GetMatch returns the record of a match previously returned by FetchMatches.
+func (s *backendService) GetMatch(ctx context.Context, req *pb.GetMatchRequest) (*pb.MatchRecord, error) {
+	if req.GetMatchId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, ".match_id is required")
+	}
+
+	return s.store.GetMatchRecord(ctx, req.GetMatchId())
+}
+
+// ListMatches returns the records of matches previously returned by FetchMatches,
+// optionally filtered by match profile and create time, a page at a time.
+func (s *backendService) ListMatches(ctx context.Context, req *pb.ListMatchesRequest) (*pb.ListMatchesResponse, error) {
+	var after, before time.Time
+	var err error
+	if req.GetCreatedAfter() != nil {
+		after, err = ptypes.Timestamp(req.GetCreatedAfter())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid .created_after: %v", err)
+		}
+	}
+	if req.GetCreatedBefore() != nil {
+		before, err = ptypes.Timestamp(req.GetCreatedBefore())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid .created_before: %v", err)
+		}
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultListMatchesPageSize
+	}
+	if pageSize > maxListMatchesPageSize {
+		pageSize = maxListMatchesPageSize
+	}
+
+	records, nextPageToken, err := s.store.ListMatchRecords(ctx, req.GetMatchProfile(), after, before, pageSize, req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListMatchesResponse{Matches: records, NextPageToken: nextPageToken}, nil
+}
+
+// ReleaseMatch releases all tickets of the specified match from the pending release.
+func (s *backendService) ReleaseMatch(ctx context.Context, req *pb.ReleaseMatchRequest) (*pb.ReleaseMatchResponse, error) {
+	if req.GetMatchId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, ".match_id is required")
+	}
+
+	record, err := s.store.GetMatchRecord(ctx, req.GetMatchId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = doReleaseTickets(ctx, record.GetTicketIds(), s.store)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReleaseMatchResponse{}, nil
+}
+
+// createMatchRecord persists the record of the match.  Match ids are only unique within a cycle, so a match
+// reusing the id of a match with a record is given a unique suffix, keeping the records of both apart.
+func createMatchRecord(ctx context.Context, match *pb.Match, store statestore.Service) error {
+	ticketIds := make([]string, 0, len(match.GetTickets()))
+	for _, t := range match.GetTickets() {
+		ticketIds = append(ticketIds, t.GetId())
+	}
+
+	record := &pb.MatchRecord{
+		MatchId:       match.GetMatchId(),
+		MatchProfile:  match.GetMatchProfile(),
+		MatchFunction: match.GetMatchFunction(),
+		TicketIds:     ticketIds,
+		BackfillId:    match.GetBackfill().GetId(),
+		CreateTime:    ptypes.TimestampNow(),
+	}
+	err := store.CreateMatchRecord(ctx, record)
+	if status.Code(err) != codes.AlreadyExists {
+		return err
+	}
+
+	record.MatchId = match.GetMatchId() + "-" + xid.New().String()
+	err = store.CreateMatchRecord(ctx, record)
+	if err != nil {
+		return err
+	}
+	match.MatchId = record.MatchId
+	return nil
+}
+
 func createOrUpdateBackfill(ctx context.Context, backfill *pb.Backfill, ticketIds []string, store statestore.Service) error {
 	if backfill.Id == "" {
 		backfill.Id = xid.New().String()
@@ -416,7 +580,71 @@ func createOrUpdateBackfill(ctx context.Context, backfill *pb.Backfill, ticketId
 	return store.IndexBackfill(ctx, b)
 }
 
-func doAssignTickets(ctx context.Context, req *pb.AssignTicketsRequest, store statestore.Service) (*pb.AssignTicketsResponse, error) {
+// withPartyMembers returns the request with each assignment group extended by
+// the members of the parties of its tickets which the request does not assign,
+// so that the assignment is delivered to all members of a party.
+func withPartyMembers(ctx context.Context, req *pb.AssignTicketsRequest, store statestore.Service) (*pb.AssignTicketsRequest, error) {
+	assigned := map[string]bool{}
+	ids := []string{}
+	for _, ag := range req.GetAssignments() {
+		for _, id := range ag.GetTicketIds() {
+			assigned[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	tickets, err := store.GetTickets(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	partyOf := map[string]string{}
+	for _, t := range tickets {
+		if t.GetPartyId() != "" {
+			partyOf[t.GetId()] = t.GetPartyId()
+		}
+	}
+	if len(partyOf) == 0 {
+		return req, nil
+	}
+
+	expanded, ok := proto.Clone(req).(*pb.AssignTicketsRequest)
+	if !ok {
+		return nil, status.Error(codes.Internal, "failed to clone input assign tickets request")
+	}
+	done := map[string]bool{}
+	for _, ag := range expanded.GetAssignments() {
+		for _, id := range ag.GetTicketIds() {
+			partyID, ok := partyOf[id]
+			if !ok || done[partyID] {
+				continue
+			}
+			done[partyID] = true
+
+			party, err := store.GetParty(ctx, partyID)
+			if status.Code(err) == codes.NotFound {
+				// The party was deleted, only its tickets still around are assigned.
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			for _, member := range party.GetTicketIds() {
+				if !assigned[member] {
+					assigned[member] = true
+					ag.TicketIds = append(ag.TicketIds, member)
+				}
+			}
+		}
+	}
+	return expanded, nil
+}
+
+func doAssignTickets(ctx context.Context, req *pb.AssignTicketsRequest, store statestore.Service, webhooks *webhook.Notifier, waitTimes *waittime.Estimator) (*pb.AssignTicketsResponse, error) {
+	req, err := withPartyMembers(ctx, req, store)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, tickets, err := store.UpdateAssignments(ctx, req)
 	if err != nil {
 		return nil, err
@@ -429,6 +657,18 @@ func doAssignTickets(ctx context.Context, req *pb.AssignTicketsRequest, store st
 		}
 	}
 
+	err = waitTimes.Record(ctx, tickets)
+	if err != nil {
+		logger.WithError(err).Error("failed to record the wait times of assigned tickets")
+	}
+
+	err = store.UpdateMatchRecordAssignments(ctx, tickets)
+	if err != nil {
+		logger.WithError(err).Error("failed to update the assignments of match records")
+	}
+
+	notifyAssignments(req, tickets, webhooks)
+
 	ids := []string{}
 
 	for _, ag := range req.Assignments {
@@ -453,6 +693,32 @@ func doAssignTickets(ctx context.Context, req *pb.AssignTicketsRequest, store st
 	return resp, nil
 }
 
+// notifyAssignments sends a webhook event for each assignment group, containing only the tickets which were assigned.
+func notifyAssignments(req *pb.AssignTicketsRequest, tickets []*pb.Ticket, webhooks *webhook.Notifier) {
+	assigned := make(map[string]struct{}, len(tickets))
+	for _, t := range tickets {
+		assigned[t.GetId()] = struct{}{}
+	}
+
+	for _, ag := range req.GetAssignments() {
+		ids := []string{}
+		for _, id := range ag.GetTicketIds() {
+			if _, ok := assigned[id]; ok {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		webhooks.Notify(&pb.WebhookEvent{
+			Type:       pb.WebhookEvent_TICKETS_ASSIGNED,
+			TicketIds:  ids,
+			Assignment: ag.GetAssignment(),
+		})
+	}
+}
+
 func recordTimeToAssignment(ctx context.Context, ticket *pb.Ticket) error {
 	if ticket.Assignment == nil {
 		return fmt.Errorf("assignment for ticket %s is nil", ticket.Id)
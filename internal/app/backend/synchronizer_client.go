@@ -3,11 +3,15 @@ package backend
 import (
 	"context"
 
+	"google.golang.org/grpc/metadata"
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/ipb"
 	"open-match.dev/open-match/internal/rpc"
 )
 
+// synchronizationPartitionMetadata is the metadata key of the synchronization partition of Synchronize calls.
+const synchronizationPartitionMetadata = "open-match-synchronization-partition"
+
 type synchronizerClient struct {
 	cacher *config.Cacher
 }
@@ -40,10 +44,15 @@ type synchronizerStream interface {
 	CloseSend() error
 }
 
-func (sc *synchronizerClient) synchronize(ctx context.Context) (synchronizerStream, error) {
+// synchronize starts a Synchronize call in the synchronization partition, which is sent in the metadata so that
+// synchronizers which predate partitions run the call in their single cycle.
+func (sc *synchronizerClient) synchronize(ctx context.Context, partition string) (synchronizerStream, error) {
 	client, err := sc.cacher.Get()
 	if err != nil {
 		return nil, err
 	}
+	if partition != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, synchronizationPartitionMetadata, partition)
+	}
 	return client.(ipb.SynchronizerClient).Synchronize(ctx)
 }
@@ -19,6 +19,7 @@ import (
 	"context"
 	"math"
 	"sort"
+	"time"
 
 	"go.opencensus.io/stats"
 
@@ -27,6 +28,7 @@ import (
 	"go.opencensus.io/stats/view"
 	"open-match.dev/open-match/internal/app/evaluator"
 	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/pkg/pb"
 )
 
@@ -52,20 +54,96 @@ type matchInp struct {
 
 // BindService define the initialization steps for this evaluator
 func BindService(p *appmain.Params, b *appmain.Bindings) error {
-	if err := evaluator.BindServiceFor(evaluate)(p, b); err != nil {
+	if err := evaluator.BindServiceFor(New(p.Config()))(p, b); err != nil {
 		return err
 	}
 	b.RegisterViews(collidedMatchesPerEvaluateView)
 	return nil
 }
 
-// evaluate sorts the matches by DefaultEvaluationCriteria.Score (optional),
+// New returns the evaluator configured under defaultEvaluator, by default the
+// same as Evaluate.
+func New(cfg config.View) evaluator.Evaluator {
+	opts := newPackingOptions(cfg)
+	wait := newWaitTimeWeighting(cfg)
+	return func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+		return evaluate(in, out, opts, wait)
+	}
+}
+
+// NewScorer returns the score by which the evaluator configured under
+// defaultEvaluator ranks a match: its DefaultEvaluationCriteria.Score, lower
+// than any other if unset, plus the bonus of defaultEvaluator.waitTime.
+func NewScorer(cfg config.View) func(m *pb.Match, now time.Time) float64 {
+	wait := newWaitTimeWeighting(cfg)
+	return func(m *pb.Match, now time.Time) float64 {
+		score := math.Inf(-1)
+		if a, ok := m.GetExtensions()["evaluation_input"]; ok {
+			inp := &pb.DefaultEvaluationCriteria{}
+			if err := ptypes.UnmarshalAny(a, inp); err == nil {
+				score = inp.GetScore()
+			}
+		}
+		if wait != nil {
+			score += wait.bonus(m, now)
+		}
+		return score
+	}
+}
+
+// Evaluate sorts the matches by DefaultEvaluationCriteria.Score (optional),
 // then returns matches which don't collide with previously returned matches.
-func evaluate(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+func Evaluate(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+	return evaluate(in, out, packingOptions{mode: modeGreedy}, nil)
+}
+
+func evaluate(in <-chan *pb.Match, out chan<- string, opts packingOptions, wait *waitTimeWeighting) error {
+	matches := readMatches(in)
+	wait.adjust(matches, time.Now())
+
+	var resultIDs []string
+	if opts.mode == modeGreedy {
+		sort.Sort(byScore(matches))
+
+		d := decollider{
+			ticketsUsed:   make(map[string]*collidingMatch),
+			backfillsUsed: make(map[string]*collidingMatch),
+			groupsUsed:    make(map[string]*collidingMatch),
+		}
+
+		for _, m := range matches {
+			d.maybeAdd(m)
+		}
+		resultIDs = d.resultIDs
+	} else {
+		for _, m := range pack(matches, opts) {
+			resultIDs = append(resultIDs, m.match.GetMatchId())
+		}
+	}
+
+	stats.Record(context.Background(), collidedMatchesPerEvaluate.M(int64(len(matches)-len(resultIDs))))
+
+	for _, id := range resultIDs {
+		out <- id
+	}
+
+	return nil
+}
+
+// readMatches reads the matches with their DefaultEvaluationCriteria.
+func readMatches(in <-chan *pb.Match) []*matchInp {
 	matches := make([]*matchInp, 0)
 	nilEvaluationInputs := 0
 
 	for m := range in {
+		if partyID, ok := partialParty(m); ok {
+			logger.WithFields(logrus.Fields{
+				"match_id": m.MatchId,
+				"party_id": partyID,
+			}).Info("Match contains only part of a party.  Rejecting match.")
+			continue
+		}
+
 		// Evaluation criteria is optional, but sort it lower than any matches which
 		// provided criteria.
 		inp := &pb.DefaultEvaluationCriteria{
@@ -96,24 +174,24 @@ func evaluate(ctx context.Context, in <-chan *pb.Match, out chan<- string) error
 		}).Info("Some matches don't have the optional field evaluation_input set.")
 	}
 
-	sort.Sort(byScore(matches))
-
-	d := decollider{
-		ticketsUsed:   make(map[string]*collidingMatch),
-		backfillsUsed: make(map[string]*collidingMatch),
-	}
+	return matches
+}
 
-	for _, m := range matches {
-		d.maybeAdd(m)
+// partialParty returns the id of a party of which the match contains some but
+// not all member tickets, if any.
+func partialParty(m *pb.Match) (string, bool) {
+	members := map[string]int32{}
+	for _, t := range m.GetTickets() {
+		if t.GetPartyId() != "" {
+			members[t.GetPartyId()]++
+		}
 	}
-
-	stats.Record(context.Background(), collidedMatchesPerEvaluate.M(int64(len(matches)-len(d.resultIDs))))
-
-	for _, id := range d.resultIDs {
-		out <- id
+	for _, t := range m.GetTickets() {
+		if id := t.GetPartyId(); id != "" && members[id] != t.GetPartySize() {
+			return id, true
+		}
 	}
-
-	return nil
+	return "", false
 }
 
 type collidingMatch struct {
@@ -125,9 +203,23 @@ type decollider struct {
 	resultIDs     []string
 	ticketsUsed   map[string]*collidingMatch
 	backfillsUsed map[string]*collidingMatch
+	groupsUsed    map[string]*collidingMatch
 }
 
 func (d *decollider) maybeAdd(m *matchInp) {
+	if g := m.inp.GetExclusionGroup(); g != "" {
+		if cm, ok := d.groupsUsed[g]; ok {
+			logger.WithFields(logrus.Fields{
+				"match_id":              m.match.GetMatchId(),
+				"exclusion_group":       g,
+				"match_score":           m.inp.GetScore(),
+				"colliding_match_id":    cm.id,
+				"colliding_match_score": cm.score,
+			}).Info("Higher quality match in the same exclusion group found. Rejecting match.")
+			return
+		}
+	}
+
 	if m.match.Backfill != nil && m.match.Backfill.Id != "" {
 		if cm, ok := d.backfillsUsed[m.match.Backfill.Id]; ok {
 			logger.WithFields(logrus.Fields{
@@ -154,6 +246,13 @@ func (d *decollider) maybeAdd(m *matchInp) {
 		}
 	}
 
+	if g := m.inp.GetExclusionGroup(); g != "" {
+		d.groupsUsed[g] = &collidingMatch{
+			id:    m.match.GetMatchId(),
+			score: m.inp.GetScore(),
+		}
+	}
+
 	if m.match.Backfill != nil && m.match.Backfill.Id != "" {
 		d.backfillsUsed[m.match.Backfill.Id] = &collidingMatch{
 			id:    m.match.GetMatchId(),
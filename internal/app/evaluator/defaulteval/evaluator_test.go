@@ -136,6 +136,62 @@ func TestEvaluate(t *testing.T) {
 		},
 	}
 
+	ticket1Group1Score5 := &pb.Match{
+		MatchId: "ticket1Group1Score5",
+		Tickets: []*pb.Ticket{ticket1},
+		Extensions: map[string]*any.Any{
+			"evaluation_input": mustAny(&pb.DefaultEvaluationCriteria{
+				Score:          5,
+				ExclusionGroup: "1",
+			}),
+		},
+	}
+
+	ticket2Group1Score10 := &pb.Match{
+		MatchId: "ticket2Group1Score10",
+		Tickets: []*pb.Ticket{ticket2},
+		Extensions: map[string]*any.Any{
+			"evaluation_input": mustAny(&pb.DefaultEvaluationCriteria{
+				Score:          10,
+				ExclusionGroup: "1",
+			}),
+		},
+	}
+
+	ticket3Group2Score1 := &pb.Match{
+		MatchId: "ticket3Group2Score1",
+		Tickets: []*pb.Ticket{ticket3},
+		Extensions: map[string]*any.Any{
+			"evaluation_input": mustAny(&pb.DefaultEvaluationCriteria{
+				Score:          1,
+				ExclusionGroup: "2",
+			}),
+		},
+	}
+
+	partyTicket1 := &pb.Ticket{Id: "p1", PartyId: "party", PartySize: 2}
+	partyTicket2 := &pb.Ticket{Id: "p2", PartyId: "party", PartySize: 2}
+
+	wholePartyScore1 := &pb.Match{
+		MatchId: "wholePartyScore1",
+		Tickets: []*pb.Ticket{partyTicket1, ticket1, partyTicket2},
+		Extensions: map[string]*any.Any{
+			"evaluation_input": mustAny(&pb.DefaultEvaluationCriteria{
+				Score: 1,
+			}),
+		},
+	}
+
+	partialPartyScore10 := &pb.Match{
+		MatchId: "partialPartyScore10",
+		Tickets: []*pb.Ticket{partyTicket1, ticket2},
+		Extensions: map[string]*any.Any{
+			"evaluation_input": mustAny(&pb.DefaultEvaluationCriteria{
+				Score: 10,
+			}),
+		},
+	}
+
 	tests := []struct {
 		description  string
 		testMatches  []*pb.Match
@@ -176,6 +232,16 @@ func TestEvaluate(t *testing.T) {
 			testMatches:  []*pb.Match{ticket12Backfill1Score1, ticket12Backfill1Score10, ticket12Backfill2Score5},
 			wantMatchIDs: []string{ticket12Backfill1Score10.GetMatchId()},
 		},
+		{
+			description:  "test deduplicates matches by exclusion group and returns match with higher score",
+			testMatches:  []*pb.Match{ticket1Group1Score5, ticket2Group1Score10, ticket3Group2Score1},
+			wantMatchIDs: []string{ticket2Group1Score10.GetMatchId(), ticket3Group2Score1.GetMatchId()},
+		},
+		{
+			description:  "test rejects matches with part of a party",
+			testMatches:  []*pb.Match{wholePartyScore1, partialPartyScore10},
+			wantMatchIDs: []string{wholePartyScore1.GetMatchId()},
+		},
 	}
 
 	for _, test := range tests {
@@ -189,7 +255,7 @@ func TestEvaluate(t *testing.T) {
 			}
 			close(in)
 
-			err := evaluate(context.Background(), in, out)
+			err := Evaluate(context.Background(), in, out)
 			require.Nil(t, err)
 
 			gotMatchIDs := []string{}
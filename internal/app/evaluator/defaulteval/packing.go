@@ -0,0 +1,329 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaulteval
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"open-match.dev/open-match/internal/config"
+)
+
+// The modes of the default evaluator, set with defaultEvaluator.mode.
+const (
+	// modeGreedy accepts matches by descending score, skipping those which
+	// collide with an accepted match.
+	modeGreedy = "greedy"
+	// modeMaxScore accepts the non-colliding matches with the highest total
+	// score.
+	modeMaxScore = "maxScore"
+	// modeMaxTickets accepts the non-colliding matches with the most tickets,
+	// breaking ties by total score.
+	modeMaxTickets = "maxTickets"
+)
+
+type packingOptions struct {
+	mode string
+	// exactComponentSize is the largest number of colliding matches solved
+	// exactly, larger components are solved by a heuristic.
+	exactComponentSize int
+	// heuristicTimeout bounds the time spent improving the heuristic
+	// solutions of an evaluation.
+	heuristicTimeout time.Duration
+}
+
+func newPackingOptions(cfg config.View) packingOptions {
+	opts := packingOptions{
+		mode:               modeGreedy,
+		exactComponentSize: 20,
+		heuristicTimeout:   100 * time.Millisecond,
+	}
+
+	switch mode := cfg.GetString("defaultEvaluator.mode"); mode {
+	case "":
+	case modeGreedy, modeMaxScore, modeMaxTickets:
+		opts.mode = mode
+	default:
+		logger.Warningf("unknown defaultEvaluator.mode %q, evaluating greedily", mode)
+	}
+	if cfg.IsSet("defaultEvaluator.exactComponentSize") {
+		opts.exactComponentSize = cfg.GetInt("defaultEvaluator.exactComponentSize")
+	}
+	if cfg.IsSet("defaultEvaluator.heuristicTimeout") {
+		opts.heuristicTimeout = cfg.GetDuration("defaultEvaluator.heuristicTimeout")
+	}
+	return opts
+}
+
+// weight is what packing maximizes, compared by primary then secondary.
+type weight struct {
+	primary, secondary float64
+}
+
+func (w weight) add(o weight) weight {
+	return weight{w.primary + o.primary, w.secondary + o.secondary}
+}
+
+func (w weight) less(o weight) bool {
+	return w.primary < o.primary || (w.primary == o.primary && w.secondary < o.secondary)
+}
+
+// positive returns w without its negative parts, bounding what adding a
+// match can gain.
+func (w weight) positive() weight {
+	return weight{math.Max(w.primary, 0), math.Max(w.secondary, 0)}
+}
+
+// packing is the conflict graph of the matches of an evaluation, where
+// matches sharing a ticket, backfill or exclusion group are neighbors.
+type packing struct {
+	opts      packingOptions
+	matches   []*matchInp
+	weights   []weight
+	neighbors [][]int
+	selected  []bool
+	deadline  time.Time
+	// blocked counts the selected neighbors of each match while solving
+	// exactly. It is shared by all components, as every search leaves it zeroed.
+	blocked []int
+}
+
+// pack returns the non-colliding matches maximizing the total weight of the
+// mode.  Matches which don't collide with any of those are returned as well,
+// as the greedy evaluator would.
+func pack(matches []*matchInp, opts packingOptions) []*matchInp {
+	sort.Stable(byScore(matches))
+
+	p := &packing{
+		opts:      opts,
+		matches:   matches,
+		weights:   make([]weight, len(matches)),
+		neighbors: make([][]int, len(matches)),
+		selected:  make([]bool, len(matches)),
+		deadline:  time.Now().Add(opts.heuristicTimeout),
+		blocked:   make([]int, len(matches)),
+	}
+
+	users := map[string][]int{}
+	for i, m := range matches {
+		score := m.inp.GetScore()
+		if math.IsInf(score, 0) || math.IsNaN(score) {
+			score = 0
+		}
+		tickets := float64(len(m.match.GetTickets()))
+		if opts.mode == modeMaxTickets {
+			p.weights[i] = weight{tickets, score}
+		} else {
+			p.weights[i] = weight{score, tickets}
+		}
+
+		for _, t := range m.match.GetTickets() {
+			users["ticket/"+t.GetId()] = append(users["ticket/"+t.GetId()], i)
+		}
+		if id := m.match.GetBackfill().GetId(); id != "" {
+			users["backfill/"+id] = append(users["backfill/"+id], i)
+		}
+		if g := m.inp.GetExclusionGroup(); g != "" {
+			users["group/"+g] = append(users["group/"+g], i)
+		}
+	}
+	for i := range matches {
+		seen := map[int]bool{i: true}
+		for _, t := range matches[i].match.GetTickets() {
+			p.addNeighbors(i, seen, users["ticket/"+t.GetId()])
+		}
+		if id := matches[i].match.GetBackfill().GetId(); id != "" {
+			p.addNeighbors(i, seen, users["backfill/"+id])
+		}
+		if g := matches[i].inp.GetExclusionGroup(); g != "" {
+			p.addNeighbors(i, seen, users["group/"+g])
+		}
+	}
+
+	for _, component := range p.components() {
+		if len(component) <= opts.exactComponentSize {
+			p.solveExactly(component)
+		} else {
+			p.solveHeuristically(component)
+		}
+	}
+
+	// Add the remaining matches which don't collide, in score order.
+	var result []*matchInp
+	for i, m := range matches {
+		if !p.selected[i] && p.selectedNeighbors(i) == 0 {
+			p.selected[i] = true
+		}
+		if p.selected[i] {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+func (p *packing) addNeighbors(i int, seen map[int]bool, users []int) {
+	for _, j := range users {
+		if !seen[j] {
+			seen[j] = true
+			p.neighbors[i] = append(p.neighbors[i], j)
+		}
+	}
+}
+
+// components returns the connected components of the conflict graph, each in
+// score order.
+func (p *packing) components() [][]int {
+	var components [][]int
+	visited := make([]bool, len(p.matches))
+	for i := range p.matches {
+		if visited[i] {
+			continue
+		}
+		visited[i] = true
+		component := []int{i}
+		for next := 0; next < len(component); next++ {
+			for _, j := range p.neighbors[component[next]] {
+				if !visited[j] {
+					visited[j] = true
+					component = append(component, j)
+				}
+			}
+		}
+		sort.Ints(component)
+		components = append(components, component)
+	}
+	return components
+}
+
+func (p *packing) selectedNeighbors(i int) int {
+	count := 0
+	for _, j := range p.neighbors[i] {
+		if p.selected[j] {
+			count++
+		}
+	}
+	return count
+}
+
+// solveExactly selects the matches of the component with the highest total
+// weight, by branch and bound.
+func (p *packing) solveExactly(component []int) {
+	// bounds[k] is the most the matches from k on can add.
+	bounds := make([]weight, len(component)+1)
+	for k := len(component) - 1; k >= 0; k-- {
+		bounds[k] = bounds[k+1].add(p.weights[component[k]].positive())
+	}
+
+	blocked := p.blocked
+	var current, best []int
+	var bestWeight weight
+	var search func(k int, w weight)
+	search = func(k int, w weight) {
+		if k == len(component) {
+			if bestWeight.less(w) {
+				best = append(best[:0], current...)
+				bestWeight = w
+			}
+			return
+		}
+		if !bestWeight.less(w.add(bounds[k])) {
+			return
+		}
+
+		i := component[k]
+		if blocked[i] == 0 && (weight{}).less(p.weights[i]) {
+			current = append(current, i)
+			for _, j := range p.neighbors[i] {
+				blocked[j]++
+			}
+			search(k+1, w.add(p.weights[i]))
+			for _, j := range p.neighbors[i] {
+				blocked[j]--
+			}
+			current = current[:len(current)-1]
+		}
+		search(k+1, w)
+	}
+	search(0, weight{})
+
+	for _, i := range best {
+		p.selected[i] = true
+	}
+}
+
+// solveHeuristically selects matches of the component greedily by weight,
+// then until the deadline swaps selected matches for sets of neighbors with
+// a higher total weight.
+func (p *packing) solveHeuristically(component []int) {
+	byWeight := append([]int(nil), component...)
+	sort.SliceStable(byWeight, func(a, b int) bool {
+		return p.weights[byWeight[b]].less(p.weights[byWeight[a]])
+	})
+	for _, i := range byWeight {
+		if (weight{}).less(p.weights[i]) && p.selectedNeighbors(i) == 0 {
+			p.selected[i] = true
+		}
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for _, i := range byWeight {
+			if time.Now().After(p.deadline) {
+				return
+			}
+			if p.selected[i] && p.swap(i) {
+				improved = true
+			}
+		}
+	}
+}
+
+// swap replaces the selected match i with the neighbors it alone blocks, if
+// those weigh more.
+func (p *packing) swap(i int) bool {
+	var candidates []int
+	for _, j := range p.neighbors[i] {
+		if !p.selected[j] && (weight{}).less(p.weights[j]) && p.selectedNeighbors(j) == 1 {
+			candidates = append(candidates, j)
+		}
+	}
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return p.weights[candidates[b]].less(p.weights[candidates[a]])
+	})
+
+	var added []int
+	var gain weight
+	blocked := map[int]bool{}
+	for _, j := range candidates {
+		if blocked[j] {
+			continue
+		}
+		added = append(added, j)
+		gain = gain.add(p.weights[j])
+		for _, k := range p.neighbors[j] {
+			blocked[k] = true
+		}
+	}
+	if !p.weights[i].less(gain) {
+		return false
+	}
+
+	p.selected[i] = false
+	for _, j := range added {
+		p.selected[j] = true
+	}
+	return true
+}
@@ -0,0 +1,295 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaulteval
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/internal/app/evaluator"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func scoredMatch(id string, score float64, backfill string, tickets ...string) *pb.Match {
+	m := &pb.Match{
+		MatchId: id,
+		Extensions: map[string]*any.Any{
+			"evaluation_input": mustAny(&pb.DefaultEvaluationCriteria{
+				Score: score,
+			}),
+		},
+	}
+	if backfill != "" {
+		m.Backfill = &pb.Backfill{Id: backfill}
+	}
+	for _, t := range tickets {
+		m.Tickets = append(m.Tickets, &pb.Ticket{Id: t})
+	}
+	return m
+}
+
+func runEvaluator(eval evaluator.Evaluator, matches []*pb.Match) []string {
+	in := make(chan *pb.Match, len(matches))
+	out := make(chan string, len(matches))
+	for _, m := range matches {
+		in <- m
+	}
+	close(in)
+
+	if err := eval(context.Background(), in, out); err != nil {
+		panic(err)
+	}
+	close(out)
+
+	ids := []string{}
+	for id := range out {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func newEvaluator(mode string, exactComponentSize int) evaluator.Evaluator {
+	cfg := viper.New()
+	cfg.Set("defaultEvaluator.mode", mode)
+	cfg.Set("defaultEvaluator.exactComponentSize", exactComponentSize)
+	return New(cfg)
+}
+
+func TestPacking(t *testing.T) {
+	best := scoredMatch("best", 10, "", "1", "2")
+	left := scoredMatch("left", 9, "", "1")
+	right := scoredMatch("right", 9, "", "2")
+	many := scoredMatch("many", 1, "", "3", "4", "5")
+	few := scoredMatch("few", 10, "", "3")
+	unscored := &pb.Match{MatchId: "unscored", Tickets: []*pb.Ticket{{Id: "6"}}}
+	backfill1 := scoredMatch("backfill1", 5, "1", "7")
+	backfill2 := scoredMatch("backfill2", 4, "1", "8")
+
+	all := []*pb.Match{best, left, right, many, few, unscored, backfill1, backfill2}
+
+	tests := []struct {
+		description string
+		mode        string
+		exact       int
+		want        []string
+	}{
+		{
+			description: "greedy keeps the best match",
+			mode:        modeGreedy,
+			exact:       20,
+			want:        []string{"best", "few", "unscored", "backfill1"},
+		},
+		{
+			description: "max score keeps two good matches over one better",
+			mode:        modeMaxScore,
+			exact:       20,
+			want:        []string{"left", "right", "few", "unscored", "backfill1"},
+		},
+		{
+			description: "max score heuristic swaps the best match for two good ones",
+			mode:        modeMaxScore,
+			exact:       0,
+			want:        []string{"left", "right", "few", "unscored", "backfill1"},
+		},
+		{
+			description: "max tickets prefers matches with more tickets",
+			mode:        modeMaxTickets,
+			exact:       20,
+			want:        []string{"left", "right", "many", "unscored", "backfill1"},
+		},
+		{
+			description: "max tickets heuristic prefers matches with more tickets",
+			mode:        modeMaxTickets,
+			exact:       0,
+			want:        []string{"left", "right", "many", "unscored", "backfill1"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+			got := runEvaluator(newEvaluator(test.mode, test.exact), all)
+			require.ElementsMatch(t, test.want, got)
+		})
+	}
+}
+
+func TestPackingExclusionGroups(t *testing.T) {
+	grouped := func(id string, score float64, group string, tickets ...string) *pb.Match {
+		m := scoredMatch(id, score, "", tickets...)
+		m.Extensions["evaluation_input"] = mustAny(&pb.DefaultEvaluationCriteria{
+			Score:          score,
+			ExclusionGroup: group,
+		})
+		return m
+	}
+	// Alternative lobbies for the same server, of which only one is accepted
+	// even though two of them together score more.
+	matches := []*pb.Match{
+		grouped("lobby-a", 10, "server", "1", "2"),
+		grouped("lobby-b", 6, "server", "3", "4"),
+		grouped("lobby-c", 6, "server", "5", "6"),
+		grouped("other", 1, "other-server", "3"),
+	}
+
+	for _, exact := range []int{20, 0} {
+		require.ElementsMatch(t, []string{"lobby-a", "other"}, runEvaluator(newEvaluator(modeMaxScore, exact), matches))
+	}
+}
+
+func TestPackingExactIsOptimal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 200; n++ {
+		matches := randomMatches(r, 12, 10, 3)
+
+		var inps []*matchInp
+		for _, m := range matches {
+			inps = append(inps, &matchInp{match: m, inp: &pb.DefaultEvaluationCriteria{Score: criteria(m)}})
+		}
+		exact := pack(inps, packingOptions{mode: modeMaxScore, exactComponentSize: 20})
+		heuristic := pack(inps, packingOptions{mode: modeMaxScore, heuristicTimeout: time.Second})
+
+		want := bruteForceBestScore(matches)
+		require.InDelta(t, want, totalScore(exact), 1e-9)
+		require.False(t, collides(exact))
+		require.False(t, collides(heuristic))
+		require.LessOrEqual(t, totalScore(heuristic), want+1e-9)
+	}
+}
+
+func criteria(m *pb.Match) float64 {
+	inp := &pb.DefaultEvaluationCriteria{}
+	if err := ptypes.UnmarshalAny(m.Extensions["evaluation_input"], inp); err != nil {
+		panic(err)
+	}
+	return inp.GetScore()
+}
+
+func totalScore(matches []*matchInp) float64 {
+	total := 0.0
+	for _, m := range matches {
+		total += m.inp.GetScore()
+	}
+	return total
+}
+
+func collides(matches []*matchInp) bool {
+	used := map[string]bool{}
+	for _, m := range matches {
+		for _, t := range m.match.GetTickets() {
+			if used[t.GetId()] {
+				return true
+			}
+			used[t.GetId()] = true
+		}
+	}
+	return false
+}
+
+func bruteForceBestScore(matches []*pb.Match) float64 {
+	best := 0.0
+	for set := 0; set < 1<<len(matches); set++ {
+		used := map[string]bool{}
+		total := 0.0
+		ok := true
+		for i, m := range matches {
+			if set&(1<<i) == 0 {
+				continue
+			}
+			for _, t := range m.GetTickets() {
+				if used[t.GetId()] {
+					ok = false
+				}
+				used[t.GetId()] = true
+			}
+			total += criteria(m)
+		}
+		if ok && total > best {
+			best = total
+		}
+	}
+	return best
+}
+
+// randomMatches returns matches of ticketsPerMatch random tickets out of
+// tickets, scored between 0 and 10.
+func randomMatches(r *rand.Rand, count, tickets, ticketsPerMatch int) []*pb.Match {
+	matches := make([]*pb.Match, 0, count)
+	for i := 0; i < count; i++ {
+		var ids []string
+		for _, t := range r.Perm(tickets)[:ticketsPerMatch] {
+			ids = append(ids, fmt.Sprintf("%d", t))
+		}
+		matches = append(matches, scoredMatch(fmt.Sprintf("%d", i), 10*r.Float64(), "", ids...))
+	}
+	return matches
+}
+
+// disjointMatches returns matches which share no tickets, so that every
+// match is a component of its own.
+func disjointMatches(r *rand.Rand, count, ticketsPerMatch int) []*pb.Match {
+	matches := make([]*pb.Match, 0, count)
+	for i := 0; i < count; i++ {
+		var ids []string
+		for t := 0; t < ticketsPerMatch; t++ {
+			ids = append(ids, fmt.Sprintf("%d", i*ticketsPerMatch+t))
+		}
+		matches = append(matches, scoredMatch(fmt.Sprintf("%d", i), 10*r.Float64(), "", ids...))
+	}
+	return matches
+}
+
+func BenchmarkEvaluate(b *testing.B) {
+	for _, size := range []int{100, 1000} {
+		for _, proposals := range []struct {
+			name    string
+			matches []*pb.Match
+		}{
+			{"random", randomMatches(rand.New(rand.NewSource(1)), size, 2*size, 4)},
+			{"disjoint", disjointMatches(rand.New(rand.NewSource(1)), size, 4)},
+		} {
+			matches := proposals.matches
+			scores := map[string]float64{}
+			for _, m := range matches {
+				scores[m.GetMatchId()] = criteria(m)
+			}
+
+			for _, mode := range []string{modeGreedy, modeMaxScore, modeMaxTickets} {
+				eval := newEvaluator(mode, 20)
+				b.Run(fmt.Sprintf("%s/%s/%d", mode, proposals.name, size), func(b *testing.B) {
+					var ids []string
+					for i := 0; i < b.N; i++ {
+						ids = runEvaluator(eval, matches)
+					}
+
+					total := 0.0
+					for _, id := range ids {
+						total += scores[id]
+					}
+					b.ReportMetric(total, "score")
+					b.ReportMetric(float64(4*len(ids)), "tickets")
+				})
+			}
+		}
+	}
+}
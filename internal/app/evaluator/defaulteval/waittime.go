@@ -0,0 +1,133 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaulteval
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// The aggregates of the wait times of the tickets of a match, set with
+// defaultEvaluator.waitTime.aggregate.
+const (
+	aggregateMax        = "max"
+	aggregateMean       = "mean"
+	aggregatePercentile = "percentile"
+)
+
+// waitTimeWeighting adds weight * (age / scale) ^ exponent to the score of a
+// match, where age aggregates how long the tickets of the match have waited
+// since their create_time, so that matches of long waiting tickets are
+// preferred.
+type waitTimeWeighting struct {
+	weight     float64
+	scale      time.Duration
+	exponent   float64
+	aggregate  string
+	percentile float64
+}
+
+// newWaitTimeWeighting returns the weighting configured under
+// defaultEvaluator.waitTime, or nil if its weight is not set.
+func newWaitTimeWeighting(cfg config.View) *waitTimeWeighting {
+	const prefix = "defaultEvaluator.waitTime."
+
+	if cfg.GetFloat64(prefix+"weight") == 0 {
+		return nil
+	}
+
+	w := &waitTimeWeighting{
+		weight:     cfg.GetFloat64(prefix + "weight"),
+		scale:      time.Minute,
+		exponent:   1,
+		aggregate:  aggregateMax,
+		percentile: 0.5,
+	}
+	if cfg.IsSet(prefix + "scale") {
+		w.scale = cfg.GetDuration(prefix + "scale")
+	}
+	if cfg.IsSet(prefix + "exponent") {
+		w.exponent = cfg.GetFloat64(prefix + "exponent")
+	}
+	if cfg.IsSet(prefix + "percentile") {
+		w.percentile = cfg.GetFloat64(prefix + "percentile")
+	}
+	switch aggregate := cfg.GetString(prefix + "aggregate"); aggregate {
+	case "":
+	case aggregateMax, aggregateMean, aggregatePercentile:
+		w.aggregate = aggregate
+	default:
+		logger.Warningf("unknown defaultEvaluator.waitTime.aggregate %q, using the max wait time", aggregate)
+	}
+	if w.scale <= 0 {
+		logger.Warningf("defaultEvaluator.waitTime.scale %s is not positive, using 1m", w.scale)
+		w.scale = time.Minute
+	}
+	return w
+}
+
+// adjust adds the wait time bonus to the scores of the matches.
+func (w *waitTimeWeighting) adjust(matches []*matchInp, now time.Time) {
+	if w == nil {
+		return
+	}
+	for _, m := range matches {
+		m.inp.Score += w.bonus(m.match, now)
+	}
+}
+
+func (w *waitTimeWeighting) bonus(m *pb.Match, now time.Time) float64 {
+	var ages []float64
+	for _, t := range m.GetTickets() {
+		age := 0.0
+		if created, err := ptypes.Timestamp(t.GetCreateTime()); err == nil && created.Before(now) {
+			age = float64(now.Sub(created)) / float64(w.scale)
+		}
+		ages = append(ages, age)
+	}
+	if len(ages) == 0 {
+		return 0
+	}
+
+	var age float64
+	switch w.aggregate {
+	case aggregateMean:
+		for _, a := range ages {
+			age += a
+		}
+		age /= float64(len(ages))
+	case aggregatePercentile:
+		sort.Float64s(ages)
+		rank := int(math.Ceil(w.percentile*float64(len(ages)))) - 1
+		if rank < 0 {
+			rank = 0
+		}
+		if rank >= len(ages) {
+			rank = len(ages) - 1
+		}
+		age = ages[rank]
+	default:
+		for _, a := range ages {
+			age = math.Max(age, a)
+		}
+	}
+
+	return w.weight * math.Pow(age, w.exponent)
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaulteval
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func waitingMatch(id string, score float64, now time.Time, waited ...time.Duration) *pb.Match {
+	m := scoredMatch(id, score, "")
+	for i, w := range waited {
+		created, err := ptypes.TimestampProto(now.Add(-w))
+		if err != nil {
+			panic(err)
+		}
+		m.Tickets = append(m.Tickets, &pb.Ticket{Id: id + "-" + string(rune('a'+i)), CreateTime: created})
+	}
+	return m
+}
+
+func TestWaitTimeBonus(t *testing.T) {
+	now := time.Now()
+	m := waitingMatch("m", 0, now, time.Minute, 2*time.Minute, 6*time.Minute)
+
+	tests := []struct {
+		description string
+		config      map[string]interface{}
+		want        float64
+	}{
+		{
+			description: "max wait time by default",
+			config:      map[string]interface{}{"weight": 2},
+			want:        12,
+		},
+		{
+			description: "mean wait time",
+			config:      map[string]interface{}{"weight": 1, "aggregate": aggregateMean},
+			want:        3,
+		},
+		{
+			description: "median wait time",
+			config:      map[string]interface{}{"weight": 1, "aggregate": aggregatePercentile, "percentile": 0.5},
+			want:        2,
+		},
+		{
+			description: "scale and exponent",
+			config:      map[string]interface{}{"weight": 1, "scale": "2m", "exponent": 2},
+			want:        9,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.description, func(t *testing.T) {
+			cfg := viper.New()
+			for k, v := range test.config {
+				cfg.Set("defaultEvaluator.waitTime."+k, v)
+			}
+			w := newWaitTimeWeighting(cfg)
+			require.NotNil(t, w)
+			require.InDelta(t, test.want, w.bonus(m, now), 1e-6)
+		})
+	}
+
+	require.Nil(t, newWaitTimeWeighting(viper.New()))
+}
+
+func TestWaitTimeWeighting(t *testing.T) {
+	now := time.Now()
+	fresh := waitingMatch("fresh", 10, now, time.Second)
+	waited := waitingMatch("waited", 5, now, time.Second, 5*time.Minute)
+	waited.Tickets[0] = fresh.Tickets[0]
+	matches := []*pb.Match{fresh, waited}
+
+	require.Equal(t, []string{"fresh"}, runEvaluator(Evaluate, matches))
+
+	cfg := viper.New()
+	cfg.Set("defaultEvaluator.waitTime.weight", 2)
+	require.Equal(t, []string{"waited"}, runEvaluator(New(cfg), matches))
+
+	cfg.Set("defaultEvaluator.mode", modeMaxScore)
+	require.Equal(t, []string{"waited"}, runEvaluator(New(cfg), matches))
+}
+
+func TestNewScorer(t *testing.T) {
+	now := time.Now()
+	m := waitingMatch("m", 3, now, 2*time.Minute)
+
+	require.Equal(t, 3.0, NewScorer(viper.New())(m, now))
+
+	cfg := viper.New()
+	cfg.Set("defaultEvaluator.waitTime.weight", 2)
+	require.InDelta(t, 7.0, NewScorer(cfg)(m, now), 1e-9)
+
+	// Matches without evaluation_input score lower than any other.
+	require.True(t, math.IsInf(NewScorer(cfg)(&pb.Match{}, now), -1))
+}
@@ -19,8 +19,11 @@ import (
 	"go.opencensus.io/stats/view"
 	"google.golang.org/grpc"
 	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/internal/schema"
 	"open-match.dev/open-match/internal/statestore"
 	"open-match.dev/open-match/internal/telemetry"
+	"open-match.dev/open-match/internal/waittime"
+	"open-match.dev/open-match/internal/webhook"
 	"open-match.dev/open-match/pkg/pb"
 )
 
@@ -58,9 +61,24 @@ var (
 
 // BindService creates the frontend service and binds it to the serving harness.
 func BindService(p *appmain.Params, b *appmain.Bindings) error {
+	webhooks, err := webhook.New(p.Config())
+	if err != nil {
+		return err
+	}
+	b.AddCloser(webhooks.Close)
+
+	sc, err := schema.New(p.Config())
+	if err != nil {
+		return err
+	}
+
+	store := statestore.New(p.Config())
 	service := &frontendService{
-		cfg:   p.Config(),
-		store: statestore.New(p.Config()),
+		cfg:       p.Config(),
+		store:     store,
+		webhooks:  webhooks,
+		waitTimes: waittime.New(p.Config(), store),
+		schema:    sc,
 	}
 
 	b.AddHealthCheckFunc(service.store.HealthCheck)
@@ -24,18 +24,28 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/trace"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/auth"
 	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/schema"
 	"open-match.dev/open-match/internal/statestore"
+	"open-match.dev/open-match/internal/waittime"
+	"open-match.dev/open-match/internal/webhook"
 	"open-match.dev/open-match/pkg/pb"
 )
 
 // frontendService implements the Frontend service that is used to create
 // Tickets and add, remove them from the pool for matchmaking.
+// If the Frontend requires authentication, callers can only use the Tickets,
+// Backfills and Parties they own, the others are reported as not found.
 type frontendService struct {
-	cfg   config.View
-	store statestore.Service
+	cfg       config.View
+	store     statestore.Service
+	webhooks  *webhook.Notifier
+	waitTimes *waittime.Estimator
+	schema    *schema.Schema
 }
 
 var (
@@ -45,34 +55,113 @@ var (
 	})
 )
 
+// callerID returns the id of the authenticated caller, or "" if the Frontend does not require authentication.
+func callerID(ctx context.Context) string {
+	if p, ok := auth.FromContext(ctx); ok {
+		return p.ID
+	}
+	return ""
+}
+
+// isOwner returns whether the authenticated caller may use a Ticket, Backfill or Party of the owner.
+// Anyone may use them if the Frontend does not require authentication, and the ones without an owner,
+// such as the Backfills created by match functions, may be used by any authenticated caller.
+func isOwner(ctx context.Context, owner string) bool {
+	p, ok := auth.FromContext(ctx)
+	return !ok || owner == "" || owner == p.ID
+}
+
+// authorizeTicket returns NotFound if the authenticated caller does not own the Ticket, as if it did not exist,
+// so that callers cannot learn about the Tickets of others.
+func authorizeTicket(ctx context.Context, id string, store statestore.Service) error {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return nil
+	}
+	ticket, err := store.GetTicket(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !isOwner(ctx, ticket.GetOwner()) {
+		return status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+	}
+	return nil
+}
+
+// authorizeBackfill returns NotFound if the authenticated caller does not own the Backfill, like authorizeTicket.
+func authorizeBackfill(ctx context.Context, backfill *pb.Backfill) error {
+	if !isOwner(ctx, backfill.GetOwner()) {
+		return status.Errorf(codes.NotFound, "Backfill id: %s not found", backfill.GetId())
+	}
+	return nil
+}
+
 // CreateTicket assigns an unique TicketId to the input Ticket and record it in state storage.
 // A ticket is considered as ready for matchmaking once it is created.
 //   - If a TicketId exists in a Ticket request, an auto-generated TicketId will override this field.
 //   - If SearchFields exist in a Ticket, CreateTicket will also index these fields such that one can query the ticket with query.QueryTickets function.
+//   - If a search field schema is configured, the SearchFields and Extensions of the Ticket must match it.
+//   - If the Frontend requires authentication, the caller becomes the owner of the Ticket.
+//   - If an idempotency key is set, retries of the call return the Ticket it created instead of creating duplicates.
 func (s *frontendService) CreateTicket(ctx context.Context, req *pb.CreateTicketRequest) (*pb.Ticket, error) {
 	// Perform input validation.
 	if req.Ticket == nil {
 		return nil, status.Errorf(codes.InvalidArgument, ".ticket is required")
 	}
-	if req.Ticket.Assignment != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "tickets cannot be created with an assignment")
-	}
-	if req.Ticket.CreateTime != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "tickets cannot be created with create time set")
+	if err := validateNewTicket(req.Ticket, s.schema); err != nil {
+		return nil, err
 	}
 
 	return doCreateTicket(ctx, req, s.store)
 }
 
+func validateNewTicket(ticket *pb.Ticket, sc *schema.Schema) error {
+	if ticket.Assignment != nil {
+		return status.Errorf(codes.InvalidArgument, "tickets cannot be created with an assignment")
+	}
+	if ticket.CreateTime != nil {
+		return status.Errorf(codes.InvalidArgument, "tickets cannot be created with create time set")
+	}
+	if ticket.PartyId != "" || ticket.PartySize != 0 {
+		return status.Errorf(codes.InvalidArgument, "tickets cannot be created with a party, use CreateParty instead")
+	}
+	return sc.ValidateTicket(ticket)
+}
+
 func doCreateTicket(ctx context.Context, req *pb.CreateTicketRequest, store statestore.Service) (*pb.Ticket, error) {
-	// Generate a ticket id and create a Ticket in state storage
-	ticket, ok := proto.Clone(req.Ticket).(*pb.Ticket)
+	ticket, err := newTicket(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IdempotencyKey != "" {
+		return store.CreateTicketIdempotent(ctx, ticket, req.IdempotencyKey)
+	}
+
+	err = store.CreateTicket(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.IndexTicket(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+// newTicket generates a ticket id and create time for a copy of the input Ticket.
+func newTicket(ctx context.Context, in *pb.Ticket) (*pb.Ticket, error) {
+	ticket, ok := proto.Clone(in).(*pb.Ticket)
 	if !ok {
 		return nil, status.Error(codes.Internal, "failed to clone input ticket proto")
 	}
 
 	ticket.Id = xid.New().String()
 	ticket.CreateTime = ptypes.TimestampNow()
+	ticket.Status = pb.Ticket_UNKNOWN
+	ticket.WaitEstimate = nil
+	ticket.Owner = callerID(ctx)
 
 	sfCount := 0
 	sfCount += len(ticket.GetSearchFields().GetDoubleArgs())
@@ -81,16 +170,6 @@ func doCreateTicket(ctx context.Context, req *pb.CreateTicketRequest, store stat
 	stats.Record(ctx, searchFieldsPerTicket.M(int64(sfCount)))
 	stats.Record(ctx, totalBytesPerTicket.M(int64(proto.Size(ticket))))
 
-	err := store.CreateTicket(ctx, ticket)
-	if err != nil {
-		return nil, err
-	}
-
-	err = store.IndexTicket(ctx, ticket)
-	if err != nil {
-		return nil, err
-	}
-
 	return ticket, nil
 }
 
@@ -99,6 +178,8 @@ func doCreateTicket(ctx context.Context, req *pb.CreateTicketRequest, store stat
 // Set initial LastAcknowledge time for this Backfill.
 // A Backfill is considered as ready for matchmaking once it is created.
 //   - If SearchFields exist in a Backfill, CreateBackfill will also index these fields such that one can query the ticket with query.QueryBackfills function.
+//   - If a search field schema is configured, the SearchFields and Extensions of the Backfill must match it.
+//   - If the Frontend requires authentication, the caller becomes the owner of the Backfill.
 func (s *frontendService) CreateBackfill(ctx context.Context, req *pb.CreateBackfillRequest) (*pb.Backfill, error) {
 	// Perform input validation.
 	if req == nil {
@@ -110,6 +191,9 @@ func (s *frontendService) CreateBackfill(ctx context.Context, req *pb.CreateBack
 	if req.Backfill.CreateTime != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "backfills cannot be created with create time set")
 	}
+	if err := s.schema.ValidateBackfill(req.Backfill); err != nil {
+		return nil, err
+	}
 
 	return doCreateBackfill(ctx, req, s.store)
 }
@@ -124,6 +208,7 @@ func doCreateBackfill(ctx context.Context, req *pb.CreateBackfillRequest, store
 	backfill.Id = xid.New().String()
 	backfill.CreateTime = ptypes.TimestampNow()
 	backfill.Generation = 1
+	backfill.Owner = callerID(ctx)
 
 	sfCount := 0
 	sfCount += len(backfill.GetSearchFields().GetDoubleArgs())
@@ -147,6 +232,7 @@ func doCreateBackfill(ctx context.Context, req *pb.CreateBackfillRequest, store
 // Update would increment generation in Redis.
 // Only Extensions and SearchFields would be updated.
 // CreateTime is not changed on Update
+// If a search field schema is configured, the new SearchFields and Extensions must match it.
 func (s *frontendService) UpdateBackfill(ctx context.Context, req *pb.UpdateBackfillRequest) (*pb.Backfill, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "request is nil")
@@ -154,6 +240,9 @@ func (s *frontendService) UpdateBackfill(ctx context.Context, req *pb.UpdateBack
 	if req.Backfill == nil {
 		return nil, status.Errorf(codes.InvalidArgument, ".backfill is required")
 	}
+	if err := s.schema.ValidateBackfill(req.Backfill); err != nil {
+		return nil, err
+	}
 
 	backfill, ok := proto.Clone(req.Backfill).(*pb.Backfill)
 	if !ok {
@@ -179,6 +268,9 @@ func (s *frontendService) UpdateBackfill(ctx context.Context, req *pb.UpdateBack
 	if err != nil {
 		return nil, err
 	}
+	if err = authorizeBackfill(ctx, bfStored); err != nil {
+		return nil, err
+	}
 
 	// Update generation here, because Frontend is used by GameServer only
 	bfStored.SearchFields = backfill.SearchFields
@@ -214,6 +306,16 @@ func (s *frontendService) DeleteBackfill(ctx context.Context, req *pb.DeleteBack
 		return nil, status.Errorf(codes.InvalidArgument, ".BackfillId is required")
 	}
 
+	if _, ok := auth.FromContext(ctx); ok {
+		bf, _, err := s.store.GetBackfill(ctx, bfID)
+		if err != nil {
+			return nil, err
+		}
+		if err = authorizeBackfill(ctx, bf); err != nil {
+			return nil, err
+		}
+	}
+
 	err := s.store.DeleteBackfillCompletely(ctx, bfID)
 	// Deleting of Backfill is inevitable when it is expired, so we don't worry about error here
 	if err != nil {
@@ -227,18 +329,32 @@ func (s *frontendService) DeleteBackfill(ctx context.Context, req *pb.DeleteBack
 // DeleteTicket immediately stops Open Match from using the Ticket for matchmaking and removes the Ticket from state storage.
 // The client must delete the Ticket when finished matchmaking with it.
 //   - If SearchFields exist in a Ticket, DeleteTicket will deindex the fields lazily.
-// Users may still be able to assign/get a ticket after calling DeleteTicket on it.
+//   - Member Tickets of a Party cannot be deleted on their own, the Party must be deleted with DeleteParty instead.
+//   - Once deleted, GetTicket returns NotFound for the Ticket and WatchTicket reports it as DELETED.
 func (s *frontendService) DeleteTicket(ctx context.Context, req *pb.DeleteTicketRequest) (*empty.Empty, error) {
 	err := doDeleteTicket(ctx, req.GetTicketId(), s.store)
 	if err != nil {
 		return nil, err
 	}
+
+	s.webhooks.Notify(&pb.WebhookEvent{
+		Type:      pb.WebhookEvent_TICKET_DELETED,
+		TicketIds: []string{req.GetTicketId()},
+	})
 	return &empty.Empty{}, nil
 }
 
 func doDeleteTicket(ctx context.Context, id string, store statestore.Service) error {
+	errs, err := ticketDeleteErrors(ctx, []string{id}, store)
+	if err != nil {
+		return err
+	}
+	if errs[id] != nil {
+		return errs[id]
+	}
+
 	// Deindex this Ticket to remove it from matchmaking pool.
-	err := store.DeindexTicket(ctx, id)
+	err = store.DeindexTicket(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -268,9 +384,404 @@ func doDeleteTicket(ctx context.Context, id string, store statestore.Service) er
 	return nil
 }
 
+// CreateParty assigns unique ids to the Party and its member Tickets, and records them in state storage at once.
+// The member Tickets are ready for matchmaking once created, and are only matched together.
+func (s *frontendService) CreateParty(ctx context.Context, req *pb.CreatePartyRequest) (*pb.CreatePartyResponse, error) {
+	if len(req.GetTickets()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, ".tickets is required")
+	}
+	for _, t := range req.GetTickets() {
+		if t.GetAssignment() != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "tickets cannot be created with an assignment")
+		}
+		if t.GetCreateTime() != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "tickets cannot be created with create time set")
+		}
+		if err := s.schema.ValidateTicket(t); err != nil {
+			return nil, err
+		}
+	}
+
+	return doCreateParty(ctx, req, s.store)
+}
+
+func doCreateParty(ctx context.Context, req *pb.CreatePartyRequest, store statestore.Service) (*pb.CreatePartyResponse, error) {
+	party := &pb.Party{
+		Id:         xid.New().String(),
+		CreateTime: ptypes.TimestampNow(),
+		Owner:      callerID(ctx),
+	}
+
+	tickets := make([]*pb.Ticket, 0, len(req.GetTickets()))
+	for _, t := range req.GetTickets() {
+		ticket, ok := proto.Clone(t).(*pb.Ticket)
+		if !ok {
+			return nil, status.Error(codes.Internal, "failed to clone input ticket proto")
+		}
+
+		ticket.Id = xid.New().String()
+		ticket.CreateTime = party.CreateTime
+		ticket.Status = pb.Ticket_UNKNOWN
+		ticket.WaitEstimate = nil
+		ticket.PartyId = party.Id
+		ticket.PartySize = int32(len(req.GetTickets()))
+		ticket.Owner = party.Owner
+		party.TicketIds = append(party.TicketIds, ticket.Id)
+		tickets = append(tickets, ticket)
+
+		sfCount := 0
+		sfCount += len(ticket.GetSearchFields().GetDoubleArgs())
+		sfCount += len(ticket.GetSearchFields().GetStringArgs())
+		sfCount += len(ticket.GetSearchFields().GetTags())
+		stats.Record(ctx, searchFieldsPerTicket.M(int64(sfCount)))
+		stats.Record(ctx, totalBytesPerTicket.M(int64(proto.Size(ticket))))
+	}
+
+	err := store.CreateParty(ctx, party, tickets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePartyResponse{Party: party, Tickets: tickets}, nil
+}
+
+// GetParty fetches a Party by its ID.
+func (s *frontendService) GetParty(ctx context.Context, req *pb.GetPartyRequest) (*pb.Party, error) {
+	if req.GetPartyId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, ".party_id is required")
+	}
+
+	party, err := s.store.GetParty(ctx, req.GetPartyId())
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner(ctx, party.GetOwner()) {
+		return nil, status.Errorf(codes.NotFound, "Party id: %s not found", req.GetPartyId())
+	}
+	return party, nil
+}
+
+// DeleteParty immediately stops Open Match from using the member Tickets of the Party for matchmaking and removes
+// the Party and its member Tickets from state storage.
+func (s *frontendService) DeleteParty(ctx context.Context, req *pb.DeletePartyRequest) (*empty.Empty, error) {
+	if req.GetPartyId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, ".party_id is required")
+	}
+
+	party, err := s.store.GetParty(ctx, req.GetPartyId())
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner(ctx, party.GetOwner()) {
+		return nil, status.Errorf(codes.NotFound, "Party id: %s not found", req.GetPartyId())
+	}
+	err = s.store.DeleteParty(ctx, req.GetPartyId())
+	if err != nil {
+		return nil, err
+	}
+
+	s.webhooks.Notify(&pb.WebhookEvent{
+		Type:      pb.WebhookEvent_TICKET_DELETED,
+		TicketIds: party.GetTicketIds(),
+	})
+	return &empty.Empty{}, nil
+}
+
+// UpdateTicket replaces the SearchFields and Extensions of a Ticket, if present.
+// Only Extensions and SearchFields would be updated, the Ticket keeps its id and CreateTime,
+// and thus its position in the queue.
+// Tickets which are pending release or assigned cannot be updated.
+func (s *frontendService) UpdateTicket(ctx context.Context, req *pb.UpdateTicketRequest) (*pb.Ticket, error) {
+	if req.GetTicket() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ".ticket is required")
+	}
+	if req.GetTicket().GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, ".ticket.id is required")
+	}
+	if err := s.schema.ValidateTicket(req.GetTicket()); err != nil {
+		return nil, err
+	}
+	if err := authorizeTicket(ctx, req.GetTicket().GetId(), s.store); err != nil {
+		return nil, err
+	}
+
+	return s.store.UpdateTicket(ctx, req.GetTicket())
+}
+
+// BatchCreateTickets creates multiple Tickets, like CreateTicket, using a single round trip to state storage.
+// Each Ticket is created independently, a failure to create one Ticket does not affect the others.
+func (s *frontendService) BatchCreateTickets(ctx context.Context, req *pb.BatchCreateTicketsRequest) (*pb.BatchCreateTicketsResponse, error) {
+	if len(req.GetTickets()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, ".tickets is required")
+	}
+
+	return doBatchCreateTickets(ctx, req, s.store, s.schema)
+}
+
+func doBatchCreateTickets(ctx context.Context, req *pb.BatchCreateTicketsRequest, store statestore.Service, sc *schema.Schema) (*pb.BatchCreateTicketsResponse, error) {
+	results := make([]*pb.TicketResult, len(req.GetTickets()))
+	tickets := make([]*pb.Ticket, 0, len(req.GetTickets()))
+	// Indexes of the results of the tickets sent to state storage.
+	indexes := make([]int, 0, len(req.GetTickets()))
+
+	for i, t := range req.GetTickets() {
+		var err error
+		if t == nil {
+			err = status.Errorf(codes.InvalidArgument, ".tickets[%d] is required", i)
+		} else {
+			err = validateNewTicket(t, sc)
+		}
+		var ticket *pb.Ticket
+		if err == nil {
+			ticket, err = newTicket(ctx, t)
+		}
+		if err != nil {
+			results[i] = &pb.TicketResult{Status: resultStatus(err)}
+			continue
+		}
+
+		tickets = append(tickets, ticket)
+		indexes = append(indexes, i)
+	}
+
+	errs, err := store.CreateTickets(ctx, tickets)
+	if err != nil {
+		return nil, err
+	}
+
+	for j, ticket := range tickets {
+		r := &pb.TicketResult{Status: resultStatus(errs[j])}
+		if errs[j] == nil {
+			r.TicketId = ticket.Id
+			r.Ticket = ticket
+		}
+		results[indexes[j]] = r
+	}
+
+	return &pb.BatchCreateTicketsResponse{Results: results}, nil
+}
+
+// resultStatus converts the error of a single Ticket of a batch into its status, which is OK if err is nil.
+func resultStatus(err error) *spb.Status {
+	if err == nil {
+		return status.New(codes.OK, "").Proto()
+	}
+	return status.Convert(err).Proto()
+}
+
+// BatchGetTickets gets multiple Tickets, like GetTicket, using a single round trip to state storage.
+// Tickets which do not exist, or were deleted or have expired, have a NotFound status.
+func (s *frontendService) BatchGetTickets(ctx context.Context, req *pb.BatchGetTicketsRequest) (*pb.BatchGetTicketsResponse, error) {
+	if len(req.GetTicketIds()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, ".ticket_ids is required")
+	}
+
+	return doBatchGetTickets(ctx, req, s.store)
+}
+
+func doBatchGetTickets(ctx context.Context, req *pb.BatchGetTicketsRequest, store statestore.Service) (*pb.BatchGetTicketsResponse, error) {
+	tickets, err := store.GetTicketsWithStatus(ctx, req.GetTicketIds())
+	if err != nil {
+		return nil, err
+	}
+
+	// Same as in doGetTicket, deleted and expired tickets are not found.
+	found := make(map[string]*pb.Ticket, len(tickets))
+	for _, t := range tickets {
+		if t.Status != pb.Ticket_DELETED && t.Status != pb.Ticket_EXPIRED && isOwner(ctx, t.GetOwner()) {
+			found[t.Id] = t
+		}
+	}
+
+	results := make([]*pb.TicketResult, len(req.GetTicketIds()))
+	for i, id := range req.GetTicketIds() {
+		t, ok := found[id]
+		if !ok {
+			results[i] = &pb.TicketResult{
+				TicketId: id,
+				Status:   status.Newf(codes.NotFound, "Ticket id: %s not found", id).Proto(),
+			}
+			continue
+		}
+		results[i] = &pb.TicketResult{TicketId: id, Ticket: t, Status: resultStatus(nil)}
+	}
+
+	return &pb.BatchGetTicketsResponse{Results: results}, nil
+}
+
+// BatchDeleteTickets deletes multiple Tickets, like DeleteTicket, using a single round trip to state storage.
+func (s *frontendService) BatchDeleteTickets(ctx context.Context, req *pb.BatchDeleteTicketsRequest) (*pb.BatchDeleteTicketsResponse, error) {
+	if len(req.GetTicketIds()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, ".ticket_ids is required")
+	}
+
+	resp, deleted, err := doBatchDeleteTickets(ctx, req.GetTicketIds(), s.store)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deleted) > 0 {
+		s.webhooks.Notify(&pb.WebhookEvent{
+			Type:      pb.WebhookEvent_TICKET_DELETED,
+			TicketIds: deleted,
+		})
+	}
+	return resp, nil
+}
+
+// doBatchDeleteTickets deindexes the Tickets and lazily deletes them, like doDeleteTicket.
+// It also returns the ids of the Tickets which were deleted.
+func doBatchDeleteTickets(ctx context.Context, ids []string, store statestore.Service) (*pb.BatchDeleteTicketsResponse, []string, error) {
+	results := make([]*pb.TicketResult, len(ids))
+	deleteErrs, err := ticketDeleteErrors(ctx, ids, store)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Indexes of the results of the tickets which can be deleted.
+	indexes := make([]int, 0, len(ids))
+	toDeindex := make([]string, 0, len(ids))
+	for i, id := range ids {
+		if deleteErrs[id] != nil {
+			results[i] = &pb.TicketResult{TicketId: id, Status: resultStatus(deleteErrs[id])}
+			continue
+		}
+		indexes = append(indexes, i)
+		toDeindex = append(toDeindex, id)
+	}
+
+	errs, err := store.DeindexTickets(ctx, toDeindex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deleted := make([]string, 0, len(toDeindex))
+	for j, id := range toDeindex {
+		results[indexes[j]] = &pb.TicketResult{TicketId: id, Status: resultStatus(errs[j])}
+		if errs[j] == nil {
+			deleted = append(deleted, id)
+		}
+	}
+
+	if len(deleted) == 0 {
+		return &pb.BatchDeleteTicketsResponse{Results: results}, deleted, nil
+	}
+
+	go func() {
+		ctx, span := trace.StartSpan(context.Background(), "open-match/frontend.BatchDeleteTicketsLazy")
+		defer span.End()
+		err := store.DeleteTickets(ctx, deleted)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"error": err.Error(),
+				"ids":   deleted,
+			}).Error("failed to delete the tickets")
+		}
+		err = store.DeleteTicketsFromPendingRelease(ctx, deleted)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"error": err.Error(),
+				"ids":   deleted,
+			}).Error("failed to delete the tickets from pendingRelease")
+		}
+	}()
+	return &pb.BatchDeleteTicketsResponse{Results: results}, deleted, nil
+}
+
+// ticketDeleteErrors returns why each of the Tickets cannot be deleted on its own, by id.
+//   - Tickets which the authenticated caller does not own, or which do not exist if the Frontend requires
+//     authentication, fail with NotFound. Otherwise deleting Tickets which do not exist is allowed.
+//   - Member Tickets of a Party fail with FailedPrecondition, since the remaining members could never be
+//     matched. The whole Party must be deleted with DeleteParty instead.
+func ticketDeleteErrors(ctx context.Context, ids []string, store statestore.Service) (map[string]error, error) {
+	tickets, err := store.GetTickets(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]*pb.Ticket, len(tickets))
+	for _, t := range tickets {
+		found[t.GetId()] = t
+	}
+
+	_, authenticated := auth.FromContext(ctx)
+	errs := map[string]error{}
+	for _, id := range ids {
+		t, ok := found[id]
+		switch {
+		case !ok && authenticated, ok && !isOwner(ctx, t.GetOwner()):
+			errs[id] = status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+		case ok && t.GetPartyId() != "":
+			errs[id] = status.Errorf(codes.FailedPrecondition, "Ticket id: %s is a member of Party id: %s, use DeleteParty instead", id, t.GetPartyId())
+		}
+	}
+	return errs, nil
+}
+
 // GetTicket get the Ticket associated with the specified TicketId.
+// Tickets which are deleted or expired are not found.
+//   - If wait estimates are enabled, the WaitEstimate of searching and pending Tickets is set.
 func (s *frontendService) GetTicket(ctx context.Context, req *pb.GetTicketRequest) (*pb.Ticket, error) {
-	return s.store.GetTicket(ctx, req.GetTicketId())
+	return doGetTicket(ctx, req.GetTicketId(), s.store, s.waitTimes)
+}
+
+func doGetTicket(ctx context.Context, id string, store statestore.Service, waitTimes *waittime.Estimator) (*pb.Ticket, error) {
+	ticket, err := store.GetTicketWithStatus(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.Status == pb.Ticket_DELETED || ticket.Status == pb.Ticket_EXPIRED || !isOwner(ctx, ticket.GetOwner()) {
+		return nil, status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+	}
+
+	if waitTimes != nil && (ticket.Status == pb.Ticket_SEARCHING || ticket.Status == pb.Ticket_PENDING) {
+		ticket.WaitEstimate, err = waitTimes.EstimateTicket(ctx, ticket)
+		// The estimate is optional, so the ticket is returned without it if it fails.
+		if err != nil {
+			logger.WithError(err).Errorf("failed to estimate the wait of ticket %s", id)
+		}
+	}
+	return ticket, nil
+}
+
+// EstimateWait estimates the wait of a new Ticket with the specified SearchFields, from the times to
+// assignment of recently assigned Tickets with the same values for the configured search fields.
+func (s *frontendService) EstimateWait(ctx context.Context, req *pb.EstimateWaitRequest) (*pb.WaitEstimate, error) {
+	return doEstimateWait(ctx, req, s.waitTimes)
+}
+
+func doEstimateWait(ctx context.Context, req *pb.EstimateWaitRequest, waitTimes *waittime.Estimator) (*pb.WaitEstimate, error) {
+	if waitTimes == nil {
+		return nil, status.Error(codes.FailedPrecondition, "wait estimates are not enabled")
+	}
+	return waitTimes.Estimate(ctx, req.GetSearchFields())
+}
+
+// WatchTicket streams back the Ticket of the specified TicketId whenever its status changes,
+// until the Ticket is deleted or expires.
+func (s *frontendService) WatchTicket(req *pb.WatchTicketRequest, stream pb.FrontendService_WatchTicketServer) error {
+	if req.GetTicketId() == "" {
+		return status.Errorf(codes.InvalidArgument, ".ticket_id is required")
+	}
+
+	sender := func(ticket *pb.Ticket) error {
+		return stream.Send(&pb.WatchTicketResponse{Ticket: ticket})
+	}
+	return doWatchTicket(stream.Context(), req.GetTicketId(), sender, s.store)
+}
+
+func doWatchTicket(ctx context.Context, id string, sender func(*pb.Ticket) error, store statestore.Service) error {
+	if err := authorizeTicket(ctx, id, store); err != nil {
+		return err
+	}
+
+	callback := func(ticket *pb.Ticket) error {
+		err := sender(ticket)
+		if err != nil {
+			return status.Errorf(codes.Aborted, err.Error())
+		}
+		return nil
+	}
+
+	return store.WatchTicket(ctx, id, callback)
 }
 
 // WatchAssignments stream back Assignment of the specified TicketId if it is updated.
@@ -291,6 +802,10 @@ func (s *frontendService) WatchAssignments(req *pb.WatchAssignmentsRequest, stre
 }
 
 func doWatchAssignments(ctx context.Context, id string, sender func(*pb.Assignment) error, store statestore.Service) error {
+	if err := authorizeTicket(ctx, id, store); err != nil {
+		return err
+	}
+
 	var currAssignment *pb.Assignment
 	var ok bool
 	callback := func(assignment *pb.Assignment) error {
@@ -341,6 +856,9 @@ func (s *frontendService) AcknowledgeBackfill(ctx context.Context, req *pb.Ackno
 	if err != nil {
 		return nil, err
 	}
+	if err = authorizeBackfill(ctx, bf); err != nil {
+		return nil, err
+	}
 
 	err = s.store.UpdateAcknowledgmentTimestamp(ctx, req.GetBackfillId())
 	if err != nil {
@@ -348,13 +866,36 @@ func (s *frontendService) AcknowledgeBackfill(ctx context.Context, req *pb.Ackno
 	}
 
 	if len(associatedTickets) != 0 {
-		resp, _, err := s.store.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		resp, tickets, err := s.store.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
 			Assignments: []*pb.AssignmentGroup{{TicketIds: associatedTickets, Assignment: req.GetAssignment()}},
 		})
 		if err != nil {
 			return nil, err
 		}
 
+		err = s.store.UpdateMatchRecordAssignments(ctx, tickets)
+		if err != nil {
+			logger.WithError(err).Error("failed to update the assignments of match records")
+		}
+
+		err = s.waitTimes.Record(ctx, tickets)
+		if err != nil {
+			logger.WithError(err).Error("failed to record the wait times of assigned tickets")
+		}
+
+		if len(tickets) != 0 {
+			ids := make([]string, 0, len(tickets))
+			for _, t := range tickets {
+				ids = append(ids, t.GetId())
+			}
+			s.webhooks.Notify(&pb.WebhookEvent{
+				Type:       pb.WebhookEvent_BACKFILL_ACKNOWLEDGED,
+				TicketIds:  ids,
+				Assignment: req.GetAssignment(),
+				BackfillId: req.GetBackfillId(),
+			})
+		}
+
 		// log errors returned from UpdateAssignments to track tickets with NotFound errors
 		for _, f := range resp.Failures {
 			logger.Errorf("failed to assign ticket %s, cause %d", f.TicketId, f.Cause)
@@ -380,5 +921,11 @@ func (s *frontendService) AcknowledgeBackfill(ctx context.Context, req *pb.Ackno
 // GetBackfill fetches a Backfill object by its ID.
 func (s *frontendService) GetBackfill(ctx context.Context, req *pb.GetBackfillRequest) (*pb.Backfill, error) {
 	bf, _, err := s.store.GetBackfill(ctx, req.GetBackfillId())
-	return bf, err
+	if err != nil {
+		return nil, err
+	}
+	if err = authorizeBackfill(ctx, bf); err != nil {
+		return nil, err
+	}
+	return bf, nil
 }
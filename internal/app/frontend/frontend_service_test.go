@@ -22,14 +22,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/auth"
+	"open-match.dev/open-match/internal/schema"
 	"open-match.dev/open-match/internal/statestore"
 	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
 	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/internal/waittime"
 	"open-match.dev/open-match/pkg/pb"
 )
 
@@ -89,12 +93,38 @@ func TestDoCreateTickets(t *testing.T) {
 	}
 }
 
+func TestDoCreateTicketIdempotencyKey(t *testing.T) {
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, viper.New())
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+
+	req := &pb.CreateTicketRequest{Ticket: &pb.Ticket{}, IdempotencyKey: "key"}
+	first, err := doCreateTicket(ctx, req, store)
+	require.NoError(t, err)
+	retry, err := doCreateTicket(ctx, req, store)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(first, retry))
+
+	// Without a key, every call creates a Ticket.
+	other, err := doCreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}}, store)
+	require.NoError(t, err)
+	require.NotEqual(t, first.Id, other.Id)
+
+	ids, err := store.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+}
+
 func TestCreateBackfill(t *testing.T) {
 	cfg := viper.New()
 	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
 	defer closer()
 	ctx := utilTesting.NewContext(t)
-	fs := frontendService{cfg, store}
+	schemaCfg := viper.New()
+	schemaCfg.Set("searchFieldSchema.stringArgs", []string{"search"})
+	sc, err := schema.New(schemaCfg)
+	require.NoError(t, err)
+	fs := frontendService{cfg: cfg, store: store, schema: sc}
 	var testCases = []struct {
 		description     string
 		request         *pb.CreateBackfillRequest
@@ -120,6 +150,17 @@ func TestCreateBackfill(t *testing.T) {
 			expectedCode:    codes.InvalidArgument,
 			expectedMessage: "backfills cannot be created with create time set",
 		},
+		{
+			description: "search fields not matching the schema",
+			request: &pb.CreateBackfillRequest{
+				Backfill: &pb.Backfill{
+					SearchFields: &pb.SearchFields{
+						StringArgs: map[string]string{
+							"serach": "me",
+						}}}},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: `.backfill.search_fields.string_args has unknown arg "serach"`,
+		},
 		{
 			description:     "empty Backfill, no errors",
 			request:         &pb.CreateBackfillRequest{Backfill: &pb.Backfill{}},
@@ -157,7 +198,7 @@ func TestCreateBackfill(t *testing.T) {
 	// expect error with canceled context
 	store, closer = statestoreTesting.NewStoreServiceForTesting(t, cfg)
 	defer closer()
-	fs = frontendService{cfg, store}
+	fs = frontendService{cfg: cfg, store: store}
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
@@ -178,7 +219,7 @@ func TestUpdateBackfill(t *testing.T) {
 	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
 	defer closer()
 	ctx := utilTesting.NewContext(t)
-	fs := frontendService{cfg, store}
+	fs := frontendService{cfg: cfg, store: store}
 	res, err := fs.CreateBackfill(ctx, &pb.CreateBackfillRequest{
 		Backfill: &pb.Backfill{
 			SearchFields: &pb.SearchFields{
@@ -248,7 +289,7 @@ func TestUpdateBackfill(t *testing.T) {
 
 	// expect error with canceled context
 	store, closer = statestoreTesting.NewStoreServiceForTesting(t, cfg)
-	fs = frontendService{cfg, store}
+	fs = frontendService{cfg: cfg, store: store}
 	defer closer()
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
@@ -308,6 +349,7 @@ func TestDoWatchAssignments(t *testing.T) {
 									Assignment: wantAssignments[i],
 								},
 							},
+							Overwrite: true,
 						})
 						require.NoError(t, err)
 						wg.Done()
@@ -370,7 +412,7 @@ func TestAcknowledgeBackfillValidation(t *testing.T) {
 
 			store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
 			defer closer()
-			fs := frontendService{cfg, store}
+			fs := frontendService{cfg: cfg, store: store}
 			bf, err := fs.AcknowledgeBackfill(ctx, test.request)
 			require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
 			require.Equal(t, test.expectedMessage, status.Convert(err).Message())
@@ -399,7 +441,7 @@ func TestAcknowledgeBackfill(t *testing.T) {
 	}
 	err := store.CreateBackfill(ctx, fakeBackfill, []string{})
 	require.NoError(t, err)
-	fs := frontendService{cfg, store}
+	fs := frontendService{cfg: cfg, store: store}
 
 	bf, err := fs.AcknowledgeBackfill(ctx, &pb.AcknowledgeBackfillRequest{BackfillId: fakeBackfill.Id, Assignment: &pb.Assignment{Connection: "10.0.0.1"}})
 	require.NoError(t, err)
@@ -475,6 +517,167 @@ func TestDoDeleteTicket(t *testing.T) {
 	}
 }
 
+func TestOwnership(t *testing.T) {
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+	alice := auth.NewContext(ctx, &auth.Principal{ID: "alice"})
+	bob := auth.NewContext(ctx, &auth.Principal{ID: "bob"})
+
+	ticket, err := doCreateTicket(alice, &pb.CreateTicketRequest{Ticket: &pb.Ticket{Owner: "bob"}}, store)
+	require.NoError(t, err)
+	require.Equal(t, "alice", ticket.Owner)
+
+	_, err = doGetTicket(alice, ticket.Id, store, nil)
+	require.NoError(t, err)
+	// The Frontend does not check owners if it does not require authentication.
+	_, err = doGetTicket(ctx, ticket.Id, store, nil)
+	require.NoError(t, err)
+	_, err = doGetTicket(bob, ticket.Id, store, nil)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	got, err := doBatchGetTickets(bob, &pb.BatchGetTicketsRequest{TicketIds: []string{ticket.Id}}, store)
+	require.NoError(t, err)
+	require.Equal(t, int32(codes.NotFound), got.Results[0].Status.Code)
+	require.Nil(t, got.Results[0].Ticket)
+
+	err = doWatchAssignments(bob, ticket.Id, func(*pb.Assignment) error { return nil }, store)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	err = doDeleteTicket(bob, ticket.Id, store)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	deleted, deletedIDs, err := doBatchDeleteTickets(bob, []string{ticket.Id}, store)
+	require.NoError(t, err)
+	require.Empty(t, deletedIDs)
+	require.Equal(t, int32(codes.NotFound), deleted.Results[0].Status.Code)
+
+	deleted, deletedIDs, err = doBatchDeleteTickets(alice, []string{ticket.Id}, store)
+	require.NoError(t, err)
+	require.Equal(t, []string{ticket.Id}, deletedIDs)
+	require.Equal(t, int32(codes.OK), deleted.Results[0].Status.Code)
+
+	fs := frontendService{cfg: cfg, store: store}
+	bf, err := fs.CreateBackfill(alice, &pb.CreateBackfillRequest{Backfill: &pb.Backfill{}})
+	require.NoError(t, err)
+	require.Equal(t, "alice", bf.Owner)
+	_, err = fs.GetBackfill(alice, &pb.GetBackfillRequest{BackfillId: bf.Id})
+	require.NoError(t, err)
+	_, err = fs.GetBackfill(bob, &pb.GetBackfillRequest{BackfillId: bf.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = fs.DeleteBackfill(bob, &pb.DeleteBackfillRequest{BackfillId: bf.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPartyMembership(t *testing.T) {
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, viper.New())
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+
+	party, err := doCreateParty(ctx, &pb.CreatePartyRequest{Tickets: []*pb.Ticket{{}, {}}}, store)
+	require.NoError(t, err)
+	require.Len(t, party.Tickets, 2)
+	member := party.Tickets[0]
+	require.Equal(t, party.Party.Id, member.PartyId)
+	require.Equal(t, int32(2), member.PartySize)
+
+	// Tickets cannot join an existing Party, or claim to be in one, when created.
+	for _, forged := range []*pb.Ticket{{PartyId: party.Party.Id}, {PartySize: 1}} {
+		err = validateNewTicket(forged, nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, "tickets cannot be created with a party, use CreateParty instead", status.Convert(err).Message())
+
+		created, err := doBatchCreateTickets(ctx, &pb.BatchCreateTicketsRequest{Tickets: []*pb.Ticket{forged}}, store, nil)
+		require.NoError(t, err)
+		require.Equal(t, int32(codes.InvalidArgument), created.Results[0].Status.Code)
+	}
+
+	// Members cannot be deleted on their own.
+	err = doDeleteTicket(ctx, member.Id, store)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	deleted, deletedIDs, err := doBatchDeleteTickets(ctx, []string{member.Id}, store)
+	require.NoError(t, err)
+	require.Empty(t, deletedIDs)
+	require.Equal(t, int32(codes.FailedPrecondition), deleted.Results[0].Status.Code)
+
+	indexed, err := store.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, indexed, 2)
+}
+
+func TestDoBatchTickets(t *testing.T) {
+	ctx := utilTesting.NewContext(t)
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, viper.New())
+	defer closer()
+	schemaCfg := viper.New()
+	schemaCfg.Set("searchFieldSchema.tags", []string{"a", "b"})
+	sc, err := schema.New(schemaCfg)
+	require.NoError(t, err)
+
+	created, err := doBatchCreateTickets(ctx, &pb.BatchCreateTicketsRequest{
+		Tickets: []*pb.Ticket{
+			{SearchFields: &pb.SearchFields{Tags: []string{"a"}}},
+			{Assignment: &pb.Assignment{}},
+			nil,
+			{SearchFields: &pb.SearchFields{Tags: []string{"b"}}},
+			{SearchFields: &pb.SearchFields{Tags: []string{"c"}}},
+		},
+	}, store, sc)
+	require.NoError(t, err)
+	require.Len(t, created.Results, 5)
+	require.Equal(t, int32(codes.OK), created.Results[0].Status.Code)
+	require.Equal(t, []string{"a"}, created.Results[0].Ticket.SearchFields.Tags)
+	require.Equal(t, int32(codes.InvalidArgument), created.Results[1].Status.Code)
+	require.Equal(t, "tickets cannot be created with an assignment", created.Results[1].Status.Message)
+	require.Empty(t, created.Results[1].TicketId)
+	require.Equal(t, int32(codes.InvalidArgument), created.Results[2].Status.Code)
+	require.Equal(t, ".tickets[2] is required", created.Results[2].Status.Message)
+	require.Equal(t, int32(codes.OK), created.Results[3].Status.Code)
+	require.Equal(t, int32(codes.InvalidArgument), created.Results[4].Status.Code)
+	require.Equal(t, `.ticket.search_fields.tags has unknown tag "c"`, created.Results[4].Status.Message)
+
+	id0, id3 := created.Results[0].TicketId, created.Results[3].TicketId
+	got, err := doBatchGetTickets(ctx, &pb.BatchGetTicketsRequest{TicketIds: []string{id3, "missing", id0}}, store)
+	require.NoError(t, err)
+	require.Len(t, got.Results, 3)
+	require.Equal(t, id3, got.Results[0].Ticket.Id)
+	require.Equal(t, int32(codes.NotFound), got.Results[1].Status.Code)
+	require.Equal(t, "missing", got.Results[1].TicketId)
+	require.Nil(t, got.Results[1].Ticket)
+	require.Equal(t, id0, got.Results[2].Ticket.Id)
+	require.Equal(t, pb.Ticket_SEARCHING, got.Results[2].Ticket.Status)
+
+	deleted, deletedIDs, err := doBatchDeleteTickets(ctx, []string{id0, id3}, store)
+	require.NoError(t, err)
+	require.Equal(t, []string{id0, id3}, deletedIDs)
+	require.Len(t, deleted.Results, 2)
+	for i, id := range deletedIDs {
+		require.Equal(t, id, deleted.Results[i].TicketId)
+		require.Equal(t, int32(codes.OK), deleted.Results[i].Status.Code)
+	}
+	indexed, err := store.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Empty(t, indexed)
+
+	// Same as GetTicket, BatchGetTickets does not find deleted tickets.
+	got, err = doBatchGetTickets(ctx, &pb.BatchGetTicketsRequest{TicketIds: []string{id0}}, store)
+	require.NoError(t, err)
+	require.Equal(t, int32(codes.NotFound), got.Results[0].Status.Code)
+	require.Nil(t, got.Results[0].Ticket)
+
+	// A fresh store has no idle connections, so the canceled context fails to get one.
+	store, closer = statestoreTesting.NewStoreServiceForTesting(t, viper.New())
+	defer closer()
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = doBatchCreateTickets(ctx, &pb.BatchCreateTicketsRequest{Tickets: []*pb.Ticket{{}}}, store, nil)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	_, err = doBatchGetTickets(ctx, &pb.BatchGetTicketsRequest{TicketIds: []string{id0}}, store)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	_, _, err = doBatchDeleteTickets(ctx, []string{id0}, store)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+}
+
 func TestDoGetTicket(t *testing.T) {
 	fakeTicket := &pb.Ticket{
 		Id: "1",
@@ -489,6 +692,7 @@ func TestDoGetTicket(t *testing.T) {
 		description string
 		preAction   func(context.Context, context.CancelFunc, statestore.Service)
 		wantTicket  *pb.Ticket
+		wantStatus  pb.Ticket_Status
 		wantCode    codes.Code
 	}{
 		{
@@ -511,6 +715,26 @@ func TestDoGetTicket(t *testing.T) {
 			},
 			wantCode:   codes.OK,
 			wantTicket: fakeTicket,
+			wantStatus: pb.Ticket_SEARCHING,
+		},
+		{
+			description: "expect ok code with an assigned status",
+			preAction: func(ctx context.Context, _ context.CancelFunc, store statestore.Service) {
+				store.CreateTicket(ctx, fakeTicket)
+				store.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+					Assignments: []*pb.AssignmentGroup{{TicketIds: []string{fakeTicket.Id}, Assignment: &pb.Assignment{Connection: "a"}}},
+				})
+			},
+			wantCode:   codes.OK,
+			wantTicket: fakeTicket,
+			wantStatus: pb.Ticket_ASSIGNED,
+		},
+		{
+			description: "expect not found code since ticket is deindexed for deletion",
+			preAction: func(ctx context.Context, _ context.CancelFunc, store statestore.Service) {
+				store.CreateTicket(ctx, fakeTicket)
+			},
+			wantCode: codes.NotFound,
 		},
 	}
 
@@ -523,17 +747,50 @@ func TestDoGetTicket(t *testing.T) {
 
 			test.preAction(ctx, cancel, store)
 
-			ticket, err := store.GetTicket(ctx, fakeTicket.GetId())
+			ticket, err := doGetTicket(ctx, fakeTicket.GetId(), store, nil)
 			require.Equal(t, test.wantCode.String(), status.Convert(err).Code().String())
 
 			if err == nil {
 				require.Equal(t, test.wantTicket.GetId(), ticket.GetId())
 				require.Equal(t, test.wantTicket.SearchFields.DoubleArgs, ticket.SearchFields.DoubleArgs)
+				require.Equal(t, test.wantStatus, ticket.Status)
 			}
 		})
 	}
 }
 
+func TestDoEstimateWait(t *testing.T) {
+	ctx := utilTesting.NewContext(t)
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+
+	_, err := doEstimateWait(ctx, &pb.EstimateWaitRequest{}, nil)
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+
+	cfg.Set("waitEstimates.enabled", true)
+	waitTimes := waittime.New(cfg, store)
+
+	ticket, err := doCreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}}, store)
+	require.NoError(t, err)
+
+	got, err := doGetTicket(ctx, ticket.Id, store, waitTimes)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), got.GetWaitEstimate().GetSampleCount())
+
+	assigned := &pb.Ticket{Id: "assigned", CreateTime: ptypes.TimestampNow()}
+	require.NoError(t, waitTimes.Record(ctx, []*pb.Ticket{assigned, assigned}))
+
+	estimate, err := doEstimateWait(ctx, &pb.EstimateWaitRequest{}, waitTimes)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), estimate.SampleCount)
+
+	// Tickets without an estimator have no estimate.
+	got, err = doGetTicket(ctx, ticket.Id, store, nil)
+	require.NoError(t, err)
+	require.Nil(t, got.WaitEstimate)
+}
+
 func TestGetBackfill(t *testing.T) {
 	fakeBackfill := &pb.Backfill{
 		Id: "1",
@@ -578,7 +835,7 @@ func TestGetBackfill(t *testing.T) {
 			ctx, cancel := context.WithCancel(utilTesting.NewContext(t))
 			store, closer := statestoreTesting.NewStoreServiceForTesting(t, viper.New())
 			defer closer()
-			fs := frontendService{cfg, store}
+			fs := frontendService{cfg: cfg, store: store}
 
 			test.preAction(ctx, cancel, store)
 
@@ -611,7 +868,7 @@ func TestDoDeleteBackfill(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg := viper.New()
-	fs := frontendService{cfg, store}
+	fs := frontendService{cfg: cfg, store: store}
 
 	tests := []struct {
 		description string
@@ -151,10 +151,15 @@ func updateTicketCache(store statestore.Service, value interface{}) error {
 	if err != nil {
 		return err
 	}
+	generations, err := store.GetTicketGenerations(context.Background())
+	if err != nil {
+		return err
+	}
 
 	deletedCount := 0
-	for id := range tickets {
-		if _, ok := currentAll[id]; !ok {
+	for id, ticket := range tickets {
+		// Updated tickets are dropped so that they are fetched again.
+		if _, ok := currentAll[id]; !ok || ticket.Generation < generations[id] {
 			delete(tickets, id)
 			deletedCount++
 		}
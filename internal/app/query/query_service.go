@@ -71,6 +71,9 @@ func (s *queryService) QueryTickets(req *pb.QueryTicketsRequest, responseServer
 		err = errors.Wrap(err, "QueryTickets: failed to run request")
 		return err
 	}
+	if pool.GetWholeParties() {
+		results = wholeParties(results)
+	}
 	stats.Record(ctx, ticketsPerQuery.M(int64(len(results))))
 
 	pSize := getPageSize(s.cfg)
@@ -103,7 +106,7 @@ func (s *queryService) QueryTicketIds(req *pb.QueryTicketIdsRequest, responseSer
 		return err
 	}
 
-	var results []string
+	var selected []*pb.Ticket
 	err = s.tc.request(ctx, func(value interface{}) {
 		tickets, ok := value.(map[string]*pb.Ticket)
 		if !ok {
@@ -111,9 +114,9 @@ func (s *queryService) QueryTicketIds(req *pb.QueryTicketIdsRequest, responseSer
 			return
 		}
 
-		for id, ticket := range tickets {
+		for _, ticket := range tickets {
 			if pf.In(ticket) {
-				results = append(results, id)
+				selected = append(selected, ticket)
 			}
 		}
 	})
@@ -121,6 +124,13 @@ func (s *queryService) QueryTicketIds(req *pb.QueryTicketIdsRequest, responseSer
 		err = errors.Wrap(err, "QueryTicketIds: failed to run request")
 		return err
 	}
+	if pool.GetWholeParties() {
+		selected = wholeParties(selected)
+	}
+	results := make([]string, 0, len(selected))
+	for _, ticket := range selected {
+		results = append(results, ticket.GetId())
+	}
 	stats.Record(ctx, ticketsPerQuery.M(int64(len(results))))
 
 	pSize := getPageSize(s.cfg)
@@ -191,6 +201,25 @@ func (s *queryService) QueryBackfills(req *pb.QueryBackfillsRequest, responseSer
 	return nil
 }
 
+// wholeParties returns the tickets without those of parties which are missing
+// any of their members.
+func wholeParties(tickets []*pb.Ticket) []*pb.Ticket {
+	members := map[string]int32{}
+	for _, ticket := range tickets {
+		if ticket.GetPartyId() != "" {
+			members[ticket.GetPartyId()]++
+		}
+	}
+
+	result := make([]*pb.Ticket, 0, len(tickets))
+	for _, ticket := range tickets {
+		if ticket.GetPartyId() == "" || members[ticket.GetPartyId()] == ticket.GetPartySize() {
+			result = append(result, ticket)
+		}
+	}
+	return result
+}
+
 func getPageSize(cfg config.View) int {
 	const (
 		name = "queryPageSize"
@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
 )
 
 func TestGetPageSize(t *testing.T) {
@@ -66,3 +67,13 @@ func TestGetPageSize(t *testing.T) {
 		})
 	}
 }
+
+func TestWholeParties(t *testing.T) {
+	solo := &pb.Ticket{Id: "solo"}
+	whole1 := &pb.Ticket{Id: "whole1", PartyId: "whole", PartySize: 2}
+	whole2 := &pb.Ticket{Id: "whole2", PartyId: "whole", PartySize: 2}
+	partial := &pb.Ticket{Id: "partial1", PartyId: "partial", PartySize: 2}
+
+	require.Equal(t, []*pb.Ticket{solo, whole1, whole2}, wholeParties([]*pb.Ticket{solo, whole1, partial, whole2}))
+	require.Empty(t, wholeParties([]*pb.Ticket{partial}))
+}
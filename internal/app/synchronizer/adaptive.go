@@ -0,0 +1,174 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"open-match.dev/open-match/internal/telemetry"
+)
+
+const (
+	// windowSmoothing is the weight given to the latest cycle when averaging
+	// observations across cycles.
+	windowSmoothing = 0.3
+	// windowHeadroom is how much larger than the observed times the windows
+	// are, so that a slightly slower cycle is not cut off.
+	windowHeadroom = 1.5
+	// windowGrowth is how fast a window grows when it was too short.
+	windowGrowth = 2
+)
+
+// cycleObservation is what a single cycle observed about its windows.
+type cycleObservation struct {
+	// registrations is the number of synchronize calls which joined the cycle.
+	registrations int
+	// lastRegistration is the time between the start of the registration
+	// window and the last synchronize call joining the cycle.
+	lastRegistration time.Duration
+	// queueDepth is the number of synchronize calls waiting to register when
+	// the registration window closed.
+	queueDepth int64
+	// mmfsDone is the time between the end of the registration window and all
+	// MMFs having sent their proposals.  Only valid if cutOff is false.
+	mmfsDone time.Duration
+	// cutOff is set if the proposal collection window closed before all MMFs
+	// were done.
+	cutOff bool
+}
+
+// adaptiveWindows sizes the registration and proposal collection windows of
+// the next cycle from what previous cycles observed, within configured bounds.
+type adaptiveWindows struct {
+	// waiting is the number of synchronize calls waiting to register, only
+	// accessed atomically.
+	waiting int64
+
+	minRegistration time.Duration
+	maxRegistration time.Duration
+	minProposal     time.Duration
+	maxProposal     time.Duration
+
+	mu           sync.Mutex
+	registration time.Duration
+	proposal     time.Duration
+	arrivals     time.Duration
+	mmfs         time.Duration
+}
+
+func newAdaptiveWindows(minRegistration, maxRegistration, minProposal, maxProposal time.Duration) *adaptiveWindows {
+	if minRegistration > maxRegistration {
+		minRegistration = maxRegistration
+	}
+	if minProposal > maxProposal {
+		minProposal = maxProposal
+	}
+
+	// Start out at the upper bounds, so that MMFs aren't cut off before
+	// anything has been observed.
+	return &adaptiveWindows{
+		minRegistration: minRegistration,
+		maxRegistration: maxRegistration,
+		minProposal:     minProposal,
+		maxProposal:     maxProposal,
+		registration:    maxRegistration,
+		proposal:        maxProposal,
+		arrivals:        maxRegistration,
+		mmfs:            maxProposal,
+	}
+}
+
+// queueDepth returns the number of synchronize calls waiting to register.
+func (w *adaptiveWindows) queueDepth() int64 {
+	return atomic.LoadInt64(&w.waiting)
+}
+
+// windows returns the registration and proposal collection windows to use for
+// the next cycle.
+func (w *adaptiveWindows) windows() (time.Duration, time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.registration, w.proposal
+}
+
+// observe updates the windows with the observations of a finished cycle.
+func (w *adaptiveWindows) observe(o cycleObservation) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Registration window: long enough for the synchronize calls which
+	// usually join a cycle to arrive.  With a single caller the last arrival
+	// is immediate, so at low load the window shrinks to its minimum.
+	if o.registrations > 0 {
+		w.arrivals = smooth(w.arrivals, o.lastRegistration)
+	}
+	registration := scale(w.arrivals, windowHeadroom)
+	if o.queueDepth > 0 {
+		// Calls are queuing up for the next cycle, batch more of them together.
+		registration = maxDuration(registration, scale(w.registration, windowGrowth))
+	}
+	w.registration = clamp(registration, w.minRegistration, w.maxRegistration)
+
+	// Proposal collection window: long enough for MMFs to finish, growing
+	// quickly whenever they were cut off.
+	if o.cutOff {
+		w.mmfs = maxDuration(w.mmfs, scale(w.proposal, windowGrowth))
+		w.proposal = clamp(w.mmfs, w.minProposal, w.maxProposal)
+		return
+	}
+	w.mmfs = smooth(w.mmfs, o.mmfsDone)
+	w.proposal = clamp(scale(w.mmfs, windowHeadroom), w.minProposal, w.maxProposal)
+}
+
+// windowBucket returns the upper bound, in milliseconds, of the iteration
+// latency bucket the window falls in.  Iterations are tagged with it rather
+// than the window itself, which would give every iteration its own tag value.
+func windowBucket(d time.Duration) string {
+	ms := float64(d) / float64(time.Millisecond)
+	for _, b := range telemetry.DefaultMillisecondsDistribution.Buckets {
+		if ms <= b {
+			return strconv.FormatFloat(b, 'f', -1, 64)
+		}
+	}
+	return "inf"
+}
+
+func smooth(avg, latest time.Duration) time.Duration {
+	return time.Duration(windowSmoothing*float64(latest) + (1-windowSmoothing)*float64(avg))
+}
+
+func scale(d time.Duration, f float64) time.Duration {
+	return time.Duration(float64(d) * f)
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func clamp(d, min, max time.Duration) time.Duration {
+	if d < min {
+		return min
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveWindowsStartAtUpperBounds(t *testing.T) {
+	w := newAdaptiveWindows(10*time.Millisecond, time.Second, 100*time.Millisecond, 10*time.Second)
+	registration, proposal := w.windows()
+	require.Equal(t, time.Second, registration)
+	require.Equal(t, 10*time.Second, proposal)
+}
+
+func TestAdaptiveWindowsShrinkAtLowLoad(t *testing.T) {
+	w := newAdaptiveWindows(10*time.Millisecond, time.Second, 100*time.Millisecond, 10*time.Second)
+	for i := 0; i < 50; i++ {
+		w.observe(cycleObservation{
+			registrations: 1,
+			mmfsDone:      time.Millisecond,
+		})
+	}
+	registration, proposal := w.windows()
+	require.Equal(t, 10*time.Millisecond, registration)
+	require.Equal(t, 100*time.Millisecond, proposal)
+}
+
+func TestAdaptiveWindowsFollowObservations(t *testing.T) {
+	w := newAdaptiveWindows(10*time.Millisecond, time.Second, 100*time.Millisecond, 10*time.Second)
+	for i := 0; i < 100; i++ {
+		w.observe(cycleObservation{
+			registrations:    5,
+			lastRegistration: 200 * time.Millisecond,
+			mmfsDone:         2 * time.Second,
+		})
+	}
+	registration, proposal := w.windows()
+	require.InDelta(t, float64(300*time.Millisecond), float64(registration), float64(time.Millisecond))
+	require.InDelta(t, float64(3*time.Second), float64(proposal), float64(time.Millisecond))
+}
+
+func TestAdaptiveWindowsGrowUnderPressure(t *testing.T) {
+	w := newAdaptiveWindows(10*time.Millisecond, time.Second, 100*time.Millisecond, 10*time.Second)
+	for i := 0; i < 50; i++ {
+		w.observe(cycleObservation{registrations: 1, mmfsDone: time.Millisecond})
+	}
+
+	w.observe(cycleObservation{registrations: 1, queueDepth: 3, cutOff: true})
+	registration, proposal := w.windows()
+	require.Equal(t, 20*time.Millisecond, registration)
+	require.Equal(t, 200*time.Millisecond, proposal)
+
+	for i := 0; i < 50; i++ {
+		w.observe(cycleObservation{registrations: 1, queueDepth: 3, cutOff: true})
+	}
+	registration, proposal = w.windows()
+	require.Equal(t, time.Second, registration)
+	require.Equal(t, 10*time.Second, proposal)
+}
+
+func TestAdaptiveWindowsInvalidBounds(t *testing.T) {
+	w := newAdaptiveWindows(time.Second, 10*time.Millisecond, 10*time.Second, 100*time.Millisecond)
+	w.observe(cycleObservation{registrations: 1, mmfsDone: time.Hour})
+	registration, proposal := w.windows()
+	require.Equal(t, 10*time.Millisecond, registration)
+	require.Equal(t, 100*time.Millisecond, proposal)
+}
+
+func TestWindowBucket(t *testing.T) {
+	require.Equal(t, "100", windowBucket(100*time.Millisecond))
+	require.Equal(t, "130", windowBucket(101*time.Millisecond))
+	require.Equal(t, "0.01", windowBucket(0))
+	require.Equal(t, "inf", windowBucket(time.Hour))
+}
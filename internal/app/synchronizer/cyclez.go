@@ -0,0 +1,131 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	cyclezEndpoint     = "/cyclez"
+	cyclezJSONEndpoint = "/cyclez/json"
+	// cycleHistorySize is the number of most recent cycles kept.
+	cycleHistorySize = 100
+	cyclezPage       = `<!DOCTYPE html>
+<head>
+	<title>Open Match Synchronizer Cycles</title>
+</head>
+<body>
+<table>
+<tr><th>Start</th><th>Partition</th><th>Registrations</th><th>Proposals per registration</th><th>Registration</th><th>Proposal collection</th><th>Evaluator</th><th>Accepted</th><th>Rejected</th><th>Pending release</th><th>Total</th><th>Cancel cause</th></tr>
+{{ range . }}
+<tr><td>{{ .Start.Format "15:04:05.000" }}</td><td>{{ .Partition }}</td><td>{{ .Registrations }}</td><td>{{ .Proposals }}</td><td>{{ .RegistrationDuration }}</td><td>{{ .ProposalCollectionDuration }}</td><td>{{ .EvaluatorLatency }}</td><td>{{ .Accepted }}</td><td>{{ .Rejected }}</td><td>{{ .PendingReleaseLatency }}</td><td>{{ .Duration }}</td><td>{{ .CancelCause }}</td></tr>
+{{ end }}
+</table>
+</body>
+`
+)
+
+var cyclezPageTemplate = template.Must(template.New("cyclez").Parse(cyclezPage))
+
+// cycleTimeline records where the time of a single cycle went.  Each field
+// is written by the stage of the cycle it describes, which all finish before
+// the cycle ends.
+type cycleTimeline struct {
+	Start     time.Time `json:"start"`
+	Partition string    `json:"partition"`
+	// Registrations is the number of Synchronize calls in the cycle.
+	Registrations int `json:"registrations"`
+	// Proposals is the number of proposals sent by each Synchronize call.
+	Proposals                  []int64       `json:"proposals"`
+	RegistrationDuration       time.Duration `json:"registration_duration"`
+	ProposalCollectionDuration time.Duration `json:"proposal_collection_duration"`
+	EvaluatorLatency           time.Duration `json:"evaluator_latency"`
+	// Accepted is the number of matches returned, Rejected the number of
+	// proposals which were not.
+	Accepted              int           `json:"accepted"`
+	Rejected              int           `json:"rejected"`
+	PendingReleaseLatency time.Duration `json:"pending_release_latency"`
+	Duration              time.Duration `json:"duration"`
+	CancelCause           string        `json:"cancel_cause,omitempty"`
+
+	// proposed is the number of proposals, other than dry runs, received by
+	// the cycle.  It is only accessed atomically, as the evaluator may fail
+	// before all are received.
+	proposed      int64
+	evaluatorDone time.Time
+}
+
+// cycleHistory keeps the timelines of the most recent cycles.
+type cycleHistory struct {
+	mu        sync.Mutex
+	timelines []*cycleTimeline
+	next      int
+}
+
+func newCycleHistory(size int) *cycleHistory {
+	return &cycleHistory{timelines: make([]*cycleTimeline, 0, size)}
+}
+
+// add records the timeline of a finished cycle, replacing the oldest one when
+// full.
+func (h *cycleHistory) add(t *cycleTimeline) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.timelines) < cap(h.timelines) {
+		h.timelines = append(h.timelines, t)
+		return
+	}
+	h.timelines[h.next] = t
+	h.next = (h.next + 1) % len(h.timelines)
+}
+
+// recent returns the recorded timelines, most recent first.
+func (h *cycleHistory) recent() []*cycleTimeline {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	n := len(h.timelines)
+	result := make([]*cycleTimeline, 0, n)
+	for i := 1; i <= n; i++ {
+		result = append(result, h.timelines[(h.next-i+n)%n])
+	}
+	return result
+}
+
+// ServeHTTP serves the /cyclez endpoint that allows a user to view the
+// timelines of the most recent cycles.
+func (h *cycleHistory) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	err := cyclezPageTemplate.Execute(w, h.recent())
+	if err != nil {
+		http.Error(w, fmt.Sprintf("cannot render HTML template, %s", err), http.StatusInternalServerError)
+	}
+}
+
+// serveJSON serves the /cyclez/json endpoint with the timelines of the most
+// recent cycles.
+func (h *cycleHistory) serveJSON(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(h.recent())
+	if err != nil {
+		http.Error(w, fmt.Sprintf("cannot encode cycles, %s", err), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCycleHistory(t *testing.T) {
+	h := newCycleHistory(3)
+	require.Empty(t, h.recent())
+
+	for _, name := range []string{"1", "2", "3", "4"} {
+		h.add(&cycleTimeline{Partition: name})
+	}
+
+	var names []string
+	for _, timeline := range h.recent() {
+		names = append(names, timeline.Partition)
+	}
+	require.Equal(t, []string{"4", "3", "2"}, names)
+}
+
+func TestCyclez(t *testing.T) {
+	h := newCycleHistory(3)
+	h.add(&cycleTimeline{
+		Partition:     "ranked",
+		Registrations: 2,
+		Proposals:     []int64{3, 4},
+		Accepted:      5,
+		Rejected:      2,
+		CancelCause:   "canceled because all callers were done",
+	})
+
+	require.HTTPSuccess(t, h.ServeHTTP, http.MethodGet, cyclezEndpoint, url.Values{})
+	require.HTTPBodyContains(t, h.ServeHTTP, http.MethodGet, cyclezEndpoint, url.Values{}, "<td>ranked</td><td>2</td><td>[3 4]</td>")
+	require.HTTPBodyContains(t, h.ServeHTTP, http.MethodGet, cyclezEndpoint, url.Values{}, "canceled because all callers were done")
+
+	w := httptest.NewRecorder()
+	h.serveJSON(w, httptest.NewRequest(http.MethodGet, cyclezJSONEndpoint, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var timelines []map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &timelines))
+	require.Len(t, timelines, 1)
+	require.Equal(t, "ranked", timelines[0]["partition"])
+	require.Equal(t, []interface{}{3.0, 4.0}, timelines[0]["proposals"])
+	require.Equal(t, 5.0, timelines[0]["accepted"])
+	require.Equal(t, 2.0, timelines[0]["rejected"])
+}
@@ -43,18 +43,28 @@ type evaluator interface {
 	evaluate(context.Context, <-chan []*pb.Match, chan<- string) error
 }
 
-var errNoEvaluatorType = status.Errorf(codes.FailedPrecondition, "unable to determine evaluator type, either api.evaluator.grpcport or api.evaluator.httpport must be specified in the config")
-
+// newEvaluator returns the evaluator configured under api.evaluator, or when
+// evaluators.routes is set, an evaluator routing proposals by profile.
 func newEvaluator(cfg config.View) evaluator {
+	def := newDeferredEvaluator(cfg, "api.evaluator")
+	if len(cfg.GetStringSlice("evaluators.routes")) == 0 {
+		return def
+	}
+	return newRoutingEvaluator(cfg, def)
+}
+
+// newDeferredEvaluator returns an evaluator configured under prefix, which
+// connects on first use.
+func newDeferredEvaluator(cfg config.View, prefix string) evaluator {
 	newInstance := func(cfg config.View) (interface{}, func(), error) {
 		// grpc is preferred over http.
-		if cfg.IsSet("api.evaluator.grpcport") {
-			return newGrpcEvaluator(cfg)
+		if cfg.IsSet(prefix + ".grpcport") {
+			return newGrpcEvaluator(cfg, prefix)
 		}
-		if cfg.IsSet("api.evaluator.httpport") {
-			return newHTTPEvaluator(cfg)
+		if cfg.IsSet(prefix + ".httpport") {
+			return newHTTPEvaluator(cfg, prefix)
 		}
-		return nil, nil, errNoEvaluatorType
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "unable to determine evaluator type, either %[1]s.grpcport or %[1]s.httpport must be specified in the config", prefix)
 	}
 
 	return &deferredEvaluator{
@@ -83,8 +93,8 @@ type grcpEvaluatorClient struct {
 	evaluator pb.EvaluatorClient
 }
 
-func newGrpcEvaluator(cfg config.View) (evaluator, func(), error) {
-	grpcAddr := fmt.Sprintf("%s:%d", cfg.GetString("api.evaluator.hostname"), cfg.GetInt64("api.evaluator.grpcport"))
+func newGrpcEvaluator(cfg config.View, prefix string) (evaluator, func(), error) {
+	grpcAddr := fmt.Sprintf("%s:%d", cfg.GetString(prefix+".hostname"), cfg.GetInt64(prefix+".grpcport"))
 	conn, err := rpc.GRPCClientFromEndpoint(cfg, grpcAddr)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create grpc evaluator client: %w", err)
@@ -173,8 +183,8 @@ type httpEvaluatorClient struct {
 	baseURL    string
 }
 
-func newHTTPEvaluator(cfg config.View) (evaluator, func(), error) {
-	httpAddr := fmt.Sprintf("%s:%d", cfg.GetString("api.evaluator.hostname"), cfg.GetInt64("api.evaluator.httpport"))
+func newHTTPEvaluator(cfg config.View, prefix string) (evaluator, func(), error) {
+	httpAddr := fmt.Sprintf("%s:%d", cfg.GetString(prefix+".hostname"), cfg.GetInt64(prefix+".httpport"))
 	client, baseURL, err := rpc.HTTPClientFromEndpoint(cfg, httpAddr)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get a HTTP client from the endpoint %v: %w", httpAddr, err)
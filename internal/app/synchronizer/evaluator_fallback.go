@@ -0,0 +1,140 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// The evaluatorFallback policies, applied when an evaluator call fails or
+// exceeds evaluatorTimeout.
+const (
+	// evaluatorFallbackCancel cancels the cycle, failing its Synchronize calls.
+	evaluatorFallbackCancel = "cancel"
+	// evaluatorFallbackDefault evaluates the remaining proposals with the
+	// default evaluator, in process.
+	evaluatorFallbackDefault = "defaulteval"
+	// evaluatorFallbackReject rejects the remaining proposals.
+	evaluatorFallbackReject = "reject"
+)
+
+// evaluatorContext returns the context of an evaluator call, which is canceled
+// evaluatorTimeout after inputDone is closed, and a function reporting whether
+// that happened.  The deadline starts once the evaluator was sent all
+// proposals, so that it does not depend on the proposal collection window.
+func (s *synchronizerService) evaluatorContext(ctx context.Context, inputDone <-chan struct{}) (context.Context, context.CancelFunc, func() bool) {
+	evalCtx, cancel := context.WithCancel(ctx)
+	var timedOut int32
+
+	if timeout := s.evaluatorTimeout(); timeout > 0 {
+		go func() {
+			select {
+			case <-inputDone:
+			case <-evalCtx.Done():
+				return
+			}
+
+			t := time.NewTimer(timeout)
+			defer t.Stop()
+			select {
+			case <-t.C:
+				atomic.StoreInt32(&timedOut, 1)
+				cancel()
+			case <-evalCtx.Done():
+			}
+		}()
+	}
+
+	return evalCtx, cancel, func() bool {
+		return atomic.LoadInt32(&timedOut) == 1
+	}
+}
+
+// evaluatorTimeout is how long the evaluator may take to return once it was
+// sent all proposals, zero for no limit.
+func (s *synchronizerService) evaluatorTimeout() time.Duration {
+	const name = "evaluatorTimeout"
+
+	if !s.cfg.IsSet(name) {
+		return 0
+	}
+
+	return s.cfg.GetDuration(name)
+}
+
+// evaluatorFallback is the policy applied when an evaluator call fails.
+func evaluatorFallback(cfg config.View) string {
+	const name = "evaluatorFallback"
+
+	if !cfg.IsSet(name) {
+		return evaluatorFallbackCancel
+	}
+
+	switch policy := cfg.GetString(name); policy {
+	case evaluatorFallbackCancel, evaluatorFallbackDefault, evaluatorFallbackReject:
+		return policy
+	default:
+		logger.Warningf("unknown evaluatorFallback %q, canceling cycles on evaluator errors", policy)
+		return evaluatorFallbackCancel
+	}
+}
+
+// evaluateWithDefault runs the default evaluator, configured under
+// defaultEvaluator, on the proposals which the failed evaluator call did not
+// accept, leaving out those which collide with the accepted ones.
+func evaluateWithDefault(ctx context.Context, eval func(context.Context, <-chan *pb.Match, chan<- string) error, proposals []*pb.Match, accepted map[string]bool, m5c chan<- string) error {
+	tickets := map[string]bool{}
+	backfills := map[string]bool{}
+	for _, m := range proposals {
+		if !accepted[m.GetMatchId()] {
+			continue
+		}
+		for _, t := range m.GetTickets() {
+			tickets[t.GetId()] = true
+		}
+		if id := m.GetBackfill().GetId(); id != "" {
+			backfills[id] = true
+		}
+	}
+
+	in := make(chan *pb.Match)
+	go func() {
+		defer close(in)
+		seen := map[string]bool{}
+	Proposals:
+		for _, m := range proposals {
+			if accepted[m.GetMatchId()] || seen[m.GetMatchId()] || backfills[m.GetBackfill().GetId()] {
+				continue
+			}
+			for _, t := range m.GetTickets() {
+				if tickets[t.GetId()] {
+					continue Proposals
+				}
+			}
+			seen[m.GetMatchId()] = true
+			in <- m
+		}
+	}()
+
+	err := eval(ctx, in, m5c)
+	for range in {
+	}
+	return err
+}
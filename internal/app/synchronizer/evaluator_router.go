@@ -0,0 +1,281 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+
+	"github.com/sirupsen/logrus"
+	"open-match.dev/open-match/internal/app/evaluator/defaulteval"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// routingEvaluator sends each proposal to the evaluator of the route listing
+// its match_profile, or to the default evaluator, and calls the evaluators of
+// a cycle concurrently.  An evaluator only resolves collisions between its own
+// proposals, so once all evaluators returned, the accepted matches colliding
+// with each other are resolved in favor of the highest scoring one.  When the
+// evaluator of a route fails, the evaluatorFallback policy is applied to its
+// proposals alone.
+type routingEvaluator struct {
+	// routes holds the evaluators by route name, the default one under "".
+	routes map[string]evaluator
+	// profiles holds the route names by profile name.
+	profiles map[string]string
+
+	fallback    string
+	defaultEval func(context.Context, <-chan *pb.Match, chan<- string) error
+	// score ranks the matches accepted by different evaluators like the
+	// default evaluator, including its wait time bonus.
+	score func(*pb.Match, time.Time) float64
+}
+
+// newRoutingEvaluator creates an evaluator for the routes configured under
+// evaluators.routes.  Each route is configured under evaluators.<name>, with
+// the hostname and grpcport or httpport of its evaluator, and the list of
+// profiles whose proposals it evaluates.
+func newRoutingEvaluator(cfg config.View, def evaluator) *routingEvaluator {
+	r := &routingEvaluator{
+		routes:      map[string]evaluator{"": def},
+		profiles:    map[string]string{},
+		fallback:    evaluatorFallback(cfg),
+		defaultEval: defaulteval.New(cfg),
+		score:       defaulteval.NewScorer(cfg),
+	}
+	for _, name := range cfg.GetStringSlice("evaluators.routes") {
+		prefix := "evaluators." + name
+		r.routes[name] = newDeferredEvaluator(cfg, prefix)
+		for _, profile := range cfg.GetStringSlice(prefix + ".profiles") {
+			r.profiles[profile] = name
+		}
+	}
+	return r
+}
+
+// routeEvaluation is the evaluator call of a route.
+type routeEvaluation struct {
+	name      string
+	in        chan *pb.Match
+	proposals []*pb.Match
+	accepted  []string
+	err       error
+}
+
+func (r *routingEvaluator) evaluate(ctx context.Context, pc <-chan []*pb.Match, acceptedIds chan<- string) error {
+	var wg sync.WaitGroup
+	proposals := map[string]*pb.Match{}
+	evaluations := map[string]*routeEvaluation{}
+
+	start := func(name string) *routeEvaluation {
+		e := &routeEvaluation{name: name, in: make(chan *pb.Match)}
+		batched := bufferMatchChannel(e.in)
+		out := make(chan string)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				for range batched {
+				}
+			}()
+			e.err = r.routes[name].evaluate(ctx, batched, out)
+			close(out)
+		}()
+		go func() {
+			defer wg.Done()
+			for id := range out {
+				e.accepted = append(e.accepted, id)
+			}
+		}()
+		return e
+	}
+
+	var err error
+Proposals:
+	for {
+		select {
+		case ms, ok := <-pc:
+			if !ok {
+				break Proposals
+			}
+			for _, m := range ms {
+				if _, ok := proposals[m.GetMatchId()]; ok {
+					err = fmt.Errorf("multiple match functions used same match_id: \"%s\"", m.GetMatchId())
+					break Proposals
+				}
+				proposals[m.GetMatchId()] = m
+
+				name := r.profiles[m.GetMatchProfile()]
+				e, ok := evaluations[name]
+				if !ok {
+					e = start(name)
+					evaluations[name] = e
+				}
+				e.proposals = append(e.proposals, m)
+				// Never blocks for long, as bufferMatchChannel always reads.
+				e.in <- m
+			}
+		case <-ctx.Done():
+			break Proposals
+		}
+	}
+	for _, e := range evaluations {
+		close(e.in)
+	}
+	wg.Wait()
+
+	// Routes are handled in order of their names, so that the results don't
+	// depend on the order in which the evaluators returned.
+	names := make([]string, 0, len(evaluations))
+	for name := range evaluations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var accepted []*pb.Match
+	for _, name := range names {
+		e := evaluations[name]
+		for _, id := range e.accepted {
+			m, ok := proposals[id]
+			if !ok || r.profiles[m.GetMatchProfile()] != name {
+				e.err = fmt.Errorf("returned match_id \"%s\" which does not correspond to any of its proposals", id)
+				break
+			}
+			accepted = append(accepted, m)
+		}
+		if e.err == nil {
+			continue
+		}
+
+		fallbackAccepted, fallbackErr := r.handleFailure(ctx, e)
+		if fallbackErr != nil && err == nil {
+			err = fallbackErr
+		}
+		accepted = append(accepted, fallbackAccepted...)
+	}
+
+	for _, m := range r.resolveCrossRouteCollisions(accepted) {
+		acceptedIds <- m.GetMatchId()
+	}
+	return err
+}
+
+// handleFailure applies the evaluatorFallback policy to the proposals of the
+// route whose evaluator failed, returning the matches it accepts in addition
+// to those the evaluator accepted.  An error is returned when the failure
+// fails the whole evaluator call, which is when the call was canceled or the
+// policy is to cancel the cycle.
+func (r *routingEvaluator) handleFailure(ctx context.Context, e *routeEvaluation) ([]*pb.Match, error) {
+	err := fmt.Errorf("error calling evaluator of route \"%s\": %w", e.name, e.err)
+	if ctx.Err() != nil || (r.fallback != evaluatorFallbackReject && r.fallback != evaluatorFallbackDefault) {
+		return nil, err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"error":    err,
+		"route":    e.name,
+		"fallback": r.fallback,
+		"accepted": len(e.accepted),
+	}).Error("error calling evaluator of route, falling back")
+	stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(fallbackKey, r.fallback)}, evaluatorFallbacks.M(1))
+
+	if r.fallback == evaluatorFallbackReject {
+		return nil, nil
+	}
+
+	accepted := map[string]bool{}
+	for _, id := range e.accepted {
+		accepted[id] = true
+	}
+	out := make(chan string)
+	var evalErr error
+	go func() {
+		evalErr = evaluateWithDefault(ctx, r.defaultEval, e.proposals, accepted, out)
+		close(out)
+	}()
+	ids := map[string]bool{}
+	for id := range out {
+		ids[id] = true
+	}
+	if evalErr != nil {
+		return nil, fmt.Errorf("error calling fallback evaluator of route \"%s\": %w", e.name, evalErr)
+	}
+
+	var matches []*pb.Match
+	for _, m := range e.proposals {
+		if ids[m.GetMatchId()] {
+			matches = append(matches, m)
+		}
+	}
+	return matches, nil
+}
+
+// resolveCrossRouteCollisions returns the accepted matches which don't collide
+// with a higher scoring one, by their score as the default evaluator ranks
+// them and then their match_id.  An evaluator already resolved the collisions
+// between its own matches, so only matches accepted by different evaluators
+// collide.
+func (r *routingEvaluator) resolveCrossRouteCollisions(accepted []*pb.Match) []*pb.Match {
+	now := time.Now()
+	scores := make(map[string]float64, len(accepted))
+	for _, m := range accepted {
+		scores[m.GetMatchId()] = r.score(m, now)
+	}
+	sort.Slice(accepted, func(i, j int) bool {
+		a, b := accepted[i].GetMatchId(), accepted[j].GetMatchId()
+		if scores[a] != scores[b] {
+			return scores[a] > scores[b]
+		}
+		return a < b
+	})
+
+	// tickets and backfills hold the resolved match ids by ticket and
+	// backfill id.
+	tickets := map[string]string{}
+	backfills := map[string]string{}
+	var resolved []*pb.Match
+	for _, m := range accepted {
+		other, ok := backfills[m.GetBackfill().GetId()]
+		for _, t := range m.GetTickets() {
+			if ok {
+				break
+			}
+			other, ok = tickets[t.GetId()]
+		}
+		if ok {
+			logger.WithFields(logrus.Fields{
+				"match_id":           m.GetMatchId(),
+				"colliding_match_id": other,
+			}).Info("Higher scoring match accepted by another evaluator has a colliding ticket or backfill. Rejecting match.")
+			continue
+		}
+
+		if bid := m.GetBackfill().GetId(); bid != "" {
+			backfills[bid] = m.GetMatchId()
+		}
+		for _, t := range m.GetTickets() {
+			tickets[t.GetId()] = m.GetMatchId()
+		}
+		resolved = append(resolved, m)
+	}
+	return resolved
+}
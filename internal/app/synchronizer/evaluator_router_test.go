@@ -0,0 +1,225 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/internal/app/evaluator/defaulteval"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// routeEvaluator accepts every proposal it receives, once wait is closed.
+type routeEvaluator struct {
+	mu       sync.Mutex
+	received []string
+	wait     chan struct{}
+}
+
+func (e *routeEvaluator) evaluate(ctx context.Context, in <-chan []*pb.Match, out chan<- string) error {
+	var ids []string
+	for ms := range in {
+		for _, m := range ms {
+			ids = append(ids, m.GetMatchId())
+		}
+	}
+	e.mu.Lock()
+	e.received = ids
+	e.mu.Unlock()
+
+	if e.wait != nil {
+		<-e.wait
+	}
+	for _, id := range ids {
+		out <- id
+	}
+	return nil
+}
+
+func TestNewRoutingEvaluator(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("evaluators.routes", []string{"ranked", "casual"})
+	cfg.Set("evaluators.ranked.hostname", "om-ranked-evaluator")
+	cfg.Set("evaluators.ranked.grpcport", 50508)
+	cfg.Set("evaluators.ranked.profiles", []string{"ranked-1v1", "ranked-2v2"})
+	cfg.Set("evaluators.casual.hostname", "om-casual-evaluator")
+	cfg.Set("evaluators.casual.httpport", 51508)
+	cfg.Set("evaluators.casual.profiles", []string{"casual"})
+
+	r, ok := newEvaluator(cfg).(*routingEvaluator)
+	require.True(t, ok)
+	require.Len(t, r.routes, 3)
+	require.Equal(t, map[string]string{
+		"ranked-1v1": "ranked",
+		"ranked-2v2": "ranked",
+		"casual":     "casual",
+	}, r.profiles)
+}
+
+func TestRoutingEvaluator(t *testing.T) {
+	score := func(s float64) map[string]*any.Any {
+		a, err := ptypes.MarshalAny(&pb.DefaultEvaluationCriteria{Score: s})
+		require.NoError(t, err)
+		return map[string]*any.Any{"evaluation_input": a}
+	}
+
+	// Whichever evaluator returns first, the collision between the matches of
+	// both is resolved in favor of the higher scoring one.
+	for _, rankedFirst := range []bool{true, false} {
+		ranked := &routeEvaluator{wait: make(chan struct{})}
+		def := &routeEvaluator{wait: make(chan struct{})}
+		r := &routingEvaluator{
+			routes:   map[string]evaluator{"": def, "ranked": ranked},
+			profiles: map[string]string{"ranked-1v1": "ranked"},
+			score:    defaulteval.NewScorer(viper.New()),
+		}
+
+		in := make(chan []*pb.Match, 1)
+		in <- []*pb.Match{
+			{MatchId: "1", MatchProfile: "ranked-1v1", Tickets: []*pb.Ticket{{Id: "a"}}, Extensions: score(1)},
+			{MatchId: "2", MatchProfile: "casual", Tickets: []*pb.Ticket{{Id: "a"}}, Extensions: score(5)},
+			{MatchId: "3", MatchProfile: "casual", Tickets: []*pb.Ticket{{Id: "b"}}},
+		}
+		close(in)
+
+		out := make(chan string, 3)
+		errc := make(chan error, 1)
+		go func() {
+			errc <- r.evaluate(context.Background(), in, out)
+			close(out)
+		}()
+
+		if rankedFirst {
+			close(ranked.wait)
+			close(def.wait)
+		} else {
+			close(def.wait)
+			close(ranked.wait)
+		}
+		var ids []string
+		for id := range out {
+			ids = append(ids, id)
+		}
+		require.NoError(t, <-errc)
+		require.Equal(t, []string{"2", "3"}, ids)
+		require.Equal(t, []string{"1"}, ranked.received)
+		require.Equal(t, []string{"2", "3"}, def.received)
+	}
+}
+
+func TestRoutingEvaluatorWaitTime(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("defaultEvaluator.waitTime.weight", 10)
+	r := newRoutingEvaluator(cfg, &routeEvaluator{})
+	r.routes["ranked"] = &routeEvaluator{}
+	r.profiles["ranked-1v1"] = "ranked"
+
+	score := func(s float64) map[string]*any.Any {
+		a, err := ptypes.MarshalAny(&pb.DefaultEvaluationCriteria{Score: s})
+		require.NoError(t, err)
+		return map[string]*any.Any{"evaluation_input": a}
+	}
+	waiting, err := ptypes.TimestampProto(time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	// The collision is resolved in favor of the match of the long waiting
+	// ticket, as the default evaluator would have.
+	in := make(chan []*pb.Match, 1)
+	in <- []*pb.Match{
+		{MatchId: "1", MatchProfile: "ranked-1v1", Tickets: []*pb.Ticket{{Id: "a"}, {Id: "b", CreateTime: waiting}}, Extensions: score(1)},
+		{MatchId: "2", MatchProfile: "casual", Tickets: []*pb.Ticket{{Id: "a"}}, Extensions: score(5)},
+	}
+	close(in)
+
+	out := make(chan string, 2)
+	require.NoError(t, r.evaluate(context.Background(), in, out))
+	close(out)
+	var ids []string
+	for id := range out {
+		ids = append(ids, id)
+	}
+	require.Equal(t, []string{"1"}, ids)
+}
+
+func TestRoutingEvaluatorFallback(t *testing.T) {
+	for _, tt := range []struct {
+		fallback string
+		want     []string
+		wantErr  bool
+	}{
+		{fallback: evaluatorFallbackCancel, want: []string{"1", "3"}, wantErr: true},
+		{fallback: evaluatorFallbackReject, want: []string{"1", "3"}},
+		{fallback: evaluatorFallbackDefault, want: []string{"1", "3", "4"}},
+	} {
+		tt := tt
+		t.Run(tt.fallback, func(t *testing.T) {
+			cfg := viper.New()
+			cfg.Set("evaluatorFallback", tt.fallback)
+			r := newRoutingEvaluator(cfg, &routeEvaluator{})
+			r.routes["ranked"] = failingEvaluator{}
+			r.profiles["ranked-1v1"] = "ranked"
+
+			// The ranked evaluator accepts its first proposal, then fails,
+			// which leaves the proposals of the default route unaffected.
+			in := make(chan []*pb.Match, 1)
+			in <- []*pb.Match{
+				{MatchId: "1", MatchProfile: "ranked-1v1", Tickets: []*pb.Ticket{{Id: "a"}}},
+				{MatchId: "2", MatchProfile: "ranked-1v1", Tickets: []*pb.Ticket{{Id: "a"}}},
+				{MatchId: "4", MatchProfile: "ranked-1v1", Tickets: []*pb.Ticket{{Id: "c"}}},
+				{MatchId: "3", MatchProfile: "casual", Tickets: []*pb.Ticket{{Id: "b"}}},
+			}
+			close(in)
+
+			out := make(chan string, 4)
+			err := r.evaluate(context.Background(), in, out)
+			close(out)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			var ids []string
+			for id := range out {
+				ids = append(ids, id)
+			}
+			require.ElementsMatch(t, tt.want, ids)
+		})
+	}
+}
+
+func TestRoutingEvaluatorDuplicateMatchID(t *testing.T) {
+	r := &routingEvaluator{
+		routes:   map[string]evaluator{"": &routeEvaluator{}, "ranked": &routeEvaluator{}},
+		profiles: map[string]string{"ranked-1v1": "ranked"},
+		score:    defaulteval.NewScorer(viper.New()),
+	}
+
+	in := make(chan []*pb.Match, 1)
+	in <- []*pb.Match{
+		{MatchId: "1", MatchProfile: "ranked-1v1"},
+		{MatchId: "1", MatchProfile: "casual"},
+	}
+	close(in)
+
+	out := make(chan string, 2)
+	require.Error(t, r.evaluate(context.Background(), in, out))
+}
@@ -0,0 +1,336 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/ipb"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/internal/statestore"
+)
+
+const (
+	// leaderLockName is the name of the statestore mutex held by the leader.
+	leaderLockName = "synchronizer_leader"
+	// leaderValueSeparator separates the address of the leader from a unique
+	// suffix in the value of the mutex.
+	leaderValueSeparator = "#"
+)
+
+// leaderElector campaigns for the synchronizer leadership by holding a
+// statestore mutex, which is renewed while leading.  The value of the mutex
+// is the address of the leader, so that followers know where to proxy
+// Synchronize calls to.
+type leaderElector struct {
+	cfg   config.View
+	store statestore.Service
+	mutex statestore.RedisLocker
+	value string
+	lease time.Duration
+
+	mu        sync.Mutex
+	leading   bool
+	resigning bool
+	termCtx   context.Context
+	endTerm   context.CancelFunc
+	conns     map[string]*grpc.ClientConn
+
+	// renewed is when the lease was last acquired or extended, only accessed
+	// by run.
+	renewed time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newLeaderElector(cfg config.View, store statestore.Service) *leaderElector {
+	const defaultLease = 10 * time.Second
+
+	lease := defaultLease
+	if cfg.IsSet("synchronizerLeaderElection.leaseDuration") {
+		lease = cfg.GetDuration("synchronizerLeaderElection.leaseDuration")
+	}
+
+	value := advertiseAddress(cfg) + leaderValueSeparator + xid.New().String()
+	l := &leaderElector{
+		cfg:   cfg,
+		store: store,
+		mutex: store.NewLeaderMutex(leaderLockName, value, lease),
+		value: value,
+		lease: lease,
+		conns: map[string]*grpc.ClientConn{},
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+	go l.run()
+	return l
+}
+
+// advertiseAddress returns the address followers use to reach this replica.
+// It defaults to the IP of the host on the synchronizer grpc port.
+func advertiseAddress(cfg config.View) string {
+	if cfg.IsSet("synchronizerLeaderElection.advertiseAddress") {
+		return cfg.GetString("synchronizerLeaderElection.advertiseAddress")
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		logger.WithError(err).Warning("failed to get hostname, advertising localhost as the synchronizer address")
+		host = "localhost"
+	}
+	if ips, err := net.LookupIP(host); err == nil {
+		for _, ip := range ips {
+			if !ip.IsLoopback() {
+				host = ip.String()
+				break
+			}
+		}
+	}
+	return net.JoinHostPort(host, cfg.GetString("api.synchronizer.grpcport"))
+}
+
+func (l *leaderElector) run() {
+	defer close(l.done)
+
+	ticker := time.NewTicker(l.lease / 3)
+	defer ticker.Stop()
+
+	for {
+		l.campaign()
+		select {
+		case <-ticker.C:
+		case <-l.stop:
+			return
+		}
+	}
+}
+
+// campaign renews the lease when leading, and otherwise tries to acquire it.
+// Only run calls it, so the state is read and swapped under mu while the calls
+// to redis are made without holding it.
+func (l *leaderElector) campaign() {
+	l.mu.Lock()
+	leading, resigning := l.leading, l.resigning
+	l.mu.Unlock()
+
+	if leading {
+		if l.renew() {
+			return
+		}
+		l.mu.Lock()
+		l.leading = false
+		endTerm := l.endTerm
+		l.mu.Unlock()
+		endTerm()
+		return
+	}
+
+	if resigning {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), l.lease/3)
+	defer cancel()
+	start := time.Now()
+	if err := l.mutex.Lock(ctx); err != nil {
+		return
+	}
+	logger.Infof("acquired synchronizer leadership as %s", l.value)
+	l.renewed = start
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.leading = true
+	l.termCtx, l.endTerm = context.WithCancel(context.Background())
+}
+
+// renew extends the lease, retrying failed attempts until a tenth of the lease
+// is left, so that this replica stops leading before another one can acquire
+// the lease.  It returns false if the leadership was lost.
+func (l *leaderElector) renew() bool {
+	deadline := l.renewed.Add(l.lease - l.lease/10)
+	for {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		start := time.Now()
+		ok, err := l.mutex.Extend(ctx)
+		cancel()
+		if err == nil && ok {
+			l.renewed = start
+			return true
+		}
+		if err == nil {
+			logger.Error("lost synchronizer leadership, the lease is held by another replica")
+			return false
+		}
+		if !time.Now().Add(l.lease / 10).Before(deadline) {
+			logger.WithError(err).Error("lost synchronizer leadership, failed to renew lease")
+			return false
+		}
+
+		logger.WithError(err).Warning("failed to renew synchronizer lease, retrying")
+		select {
+		case <-time.After(l.lease / 10):
+		case <-l.stop:
+			// close releases the lease.
+			return true
+		}
+	}
+}
+
+// term returns a context which is canceled when the current leadership term
+// ends, and false if this replica is not accepting cycles as the leader.
+func (l *leaderElector) term() (context.Context, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.leading || l.resigning {
+		return nil, false
+	}
+	return l.termCtx, true
+}
+
+// stepDown stops this replica from starting new cycles, while still holding
+// the lease so that a cycle in flight can finish before another replica
+// takes over.
+func (l *leaderElector) stepDown() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.resigning = true
+}
+
+// close stops campaigning and releases the lease if held.
+func (l *leaderElector) close() {
+	l.stepDown()
+	close(l.stop)
+	<-l.done
+
+	l.mu.Lock()
+	leading := l.leading
+	l.leading = false
+	endTerm := l.endTerm
+	l.mu.Unlock()
+
+	if leading {
+		ctx, cancel := context.WithTimeout(context.Background(), l.lease)
+		defer cancel()
+		if _, err := l.mutex.Unlock(ctx); err != nil {
+			logger.WithError(err).Warning("failed to release synchronizer leadership")
+		}
+		endTerm()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for addr, conn := range l.conns {
+		if err := conn.Close(); err != nil {
+			logger.WithError(err).Warningf("failed to close connection to synchronizer %s", addr)
+		}
+	}
+}
+
+// leaderClient returns a client for the current leader.
+func (l *leaderElector) leaderClient(ctx context.Context) (ipb.SynchronizerClient, error) {
+	holder, err := l.store.GetLockHolder(ctx, leaderLockName)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.Unavailable, "no synchronizer leader is elected")
+		}
+		return nil, err
+	}
+	if holder == l.value {
+		return nil, status.Error(codes.Unavailable, "synchronizer leader is handing over")
+	}
+
+	i := strings.LastIndex(holder, leaderValueSeparator)
+	if i < 0 {
+		return nil, status.Errorf(codes.Internal, "invalid synchronizer leader %s", holder)
+	}
+	addr := holder[:i]
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	conn, ok := l.conns[addr]
+	if !ok {
+		conn, err = rpc.GRPCClientFromEndpoint(l.cfg, addr)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to connect to synchronizer leader %s: %v", addr, err)
+		}
+		l.conns[addr] = conn
+	}
+	return ipb.NewSynchronizerClient(conn), nil
+}
+
+// proxy forwards a Synchronize call to the current leader.
+func (l *leaderElector) proxy(stream ipb.Synchronizer_SynchronizeServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	client, err := l.leaderClient(ctx)
+	if err != nil {
+		return err
+	}
+	if partition := streamPartition(ctx); partition != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, partitionMetadata, partition)
+	}
+	upstream, err := client.Synchronize(ctx)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				if err = upstream.CloseSend(); err != nil {
+					cancel()
+				}
+				return
+			}
+			if err != nil {
+				cancel()
+				return
+			}
+			if err = upstream.Send(req); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	for {
+		resp, err := upstream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"open-match.dev/open-match/internal/statestore"
+	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
+)
+
+func TestLeaderElection(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("synchronizerLeaderElection.leaseDuration", 30*time.Millisecond)
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+
+	cfg.Set("synchronizerLeaderElection.advertiseAddress", "first:1")
+	first := newLeaderElector(cfg, store)
+	requireLeading(t, first)
+
+	cfg.Set("synchronizerLeaderElection.advertiseAddress", "second:1")
+	second := newLeaderElector(cfg, store)
+	defer second.close()
+
+	// The leader keeps its lease while the other replica campaigns.
+	time.Sleep(100 * time.Millisecond)
+	termCtx, ok := first.term()
+	require.True(t, ok)
+	_, ok = second.term()
+	require.False(t, ok)
+
+	_, err := second.leaderClient(context.Background())
+	require.NoError(t, err)
+
+	// Stepping down stops new cycles, but keeps the lease until closed.
+	first.stepDown()
+	_, ok = first.term()
+	require.False(t, ok)
+	require.NoError(t, termCtx.Err())
+	time.Sleep(100 * time.Millisecond)
+	_, ok = second.term()
+	require.False(t, ok)
+
+	first.close()
+	require.Error(t, termCtx.Err())
+	requireLeading(t, second)
+}
+
+// flakyLocker fails the given number of Extend calls, each after a delay.
+type flakyLocker struct {
+	statestore.RedisLocker
+	failures int32
+	delay    time.Duration
+}
+
+func (f *flakyLocker) Extend(ctx context.Context) (bool, error) {
+	if atomic.AddInt32(&f.failures, -1) >= 0 {
+		time.Sleep(f.delay)
+		return false, errors.New("extend failed")
+	}
+	return f.RedisLocker.Extend(ctx)
+}
+
+func TestLeaderRenewalRetries(t *testing.T) {
+	const lease = 300 * time.Millisecond
+
+	cfg := viper.New()
+	cfg.Set("synchronizerLeaderElection.leaseDuration", lease)
+	cfg.Set("synchronizerLeaderElection.advertiseAddress", "leader:1")
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+
+	locker := &flakyLocker{RedisLocker: store.NewLeaderMutex(leaderLockName, "leader:1#1", lease), delay: lease / 10}
+	l := &leaderElector{
+		cfg:   cfg,
+		store: store,
+		mutex: locker,
+		value: "leader:1#1",
+		lease: lease,
+		conns: map[string]*grpc.ClientConn{},
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+	go l.run()
+	defer l.close()
+	requireLeading(t, l)
+	termCtx, _ := l.term()
+
+	// A failed renewal is retried within the lease, without blocking term.
+	atomic.StoreInt32(&locker.failures, 2)
+	start := time.Now()
+	for atomic.LoadInt32(&locker.failures) >= 0 {
+		_, ok := l.term()
+		require.True(t, ok)
+		require.Less(t, int64(time.Since(start)), int64(lease))
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(lease)
+	require.NoError(t, termCtx.Err())
+
+	// The leader steps down before the lease would expire.
+	atomic.StoreInt32(&locker.failures, 1000)
+	select {
+	case <-termCtx.Done():
+	case <-time.After(lease):
+		require.Fail(t, "leader did not step down within the lease")
+	}
+}
+
+func requireLeading(t *testing.T, l *leaderElector) {
+	require.Eventually(t, func() bool {
+		_, ok := l.term()
+		return ok
+	}, time.Second, 5*time.Millisecond)
+}
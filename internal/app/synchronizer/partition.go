@@ -0,0 +1,242 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// partitionMetadata is the metadata key of the synchronization partition of
+// Synchronize calls.
+const partitionMetadata = "open-match-synchronization-partition"
+
+// partition runs the cycles of the Synchronize calls of one synchronization
+// partition.  Cycles of different partitions run concurrently.
+type partition struct {
+	name string
+
+	synchronizeRegistration chan *registrationRequest
+
+	// startCycle is a buffered channel for containing a single value.  The value
+	// is present only when no cycle is registering or collecting proposals.
+	startCycle chan struct{}
+
+	// windows sizes the registration and proposal collection windows when
+	// adaptiveWindows.enabled is set, nil otherwise.
+	windows *adaptiveWindows
+
+	// users is the number of Synchronize calls using the partition, guarded
+	// by partitionsMu.
+	users int
+}
+
+// streamPartition returns the synchronization partition sent in the metadata
+// of a Synchronize call.  Backends which predate partitions send none, so
+// their calls run in the default partition.
+func streamPartition(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(partitionMetadata); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// partition returns the partition with the given name, creating it on first
+// use.  The caller must call releasePartition once done with it.
+func (s *synchronizerService) partition(name string) *partition {
+	s.partitionsMu.Lock()
+	defer s.partitionsMu.Unlock()
+
+	if p, ok := s.partitions[name]; ok {
+		p.users++
+		return p
+	}
+
+	p := &partition{
+		name:                    name,
+		synchronizeRegistration: make(chan *registrationRequest),
+		startCycle:              make(chan struct{}, 1),
+	}
+	if s.cfg.GetBool("adaptiveWindows.enabled") {
+		p.windows = newAdaptiveWindows(
+			s.windowBound("adaptiveWindows.minRegistrationInterval", s.registrationInterval()/10),
+			s.windowBound("adaptiveWindows.maxRegistrationInterval", s.registrationInterval()),
+			s.windowBound("adaptiveWindows.minProposalCollectionInterval", s.proposalCollectionInterval()/10),
+			s.windowBound("adaptiveWindows.maxProposalCollectionInterval", 2*s.proposalCollectionInterval()),
+		)
+	}
+	p.startCycle <- struct{}{}
+	p.users = 1
+
+	s.partitions[name] = p
+	return p
+}
+
+// releasePartition records that a Synchronize call is done with the partition,
+// evicting it if idle.
+func (s *synchronizerService) releasePartition(p *partition) {
+	s.partitionsMu.Lock()
+	defer s.partitionsMu.Unlock()
+
+	p.users--
+	s.evictIdleLocked(p)
+}
+
+// evictIdle evicts the partition if idle, once a cycle of it ended.
+func (s *synchronizerService) evictIdle(p *partition) {
+	s.partitionsMu.Lock()
+	defer s.partitionsMu.Unlock()
+	s.evictIdleLocked(p)
+}
+
+// evictIdleLocked removes the partition if no Synchronize call uses it and no
+// cycle of it is registering or collecting proposals, so that partitions of
+// profiles which are no longer fetched don't accumulate.  A pipelined cycle
+// may still be running, which is fine as cycles of different partitions
+// already run concurrently.
+func (s *synchronizerService) evictIdleLocked(p *partition) {
+	if p.users > 0 || s.partitions[p.name] != p {
+		return
+	}
+	select {
+	case <-p.startCycle:
+		delete(s.partitions, p.name)
+	default:
+	}
+}
+
+// ticketClaims keeps concurrent cycles, of different partitions or pipelined
+// cycles of the same partition, from returning matches with the same tickets.
+// A cycle may have queried tickets before another cycle added them to the
+// pending release, so tickets are claimed by the cycle which added them until
+// every cycle which was running at the time has ended.
+type ticketClaims struct {
+	mu     sync.Mutex
+	claims map[string]*ticketClaim
+	active map[*cycle]bool
+}
+
+// cycle identifies a running cycle.
+type cycle struct {
+	partition *partition
+	start     time.Time
+}
+
+type ticketClaim struct {
+	owner *cycle
+	// at is when the tickets were added to the pending release, zero while
+	// that is still in progress.
+	at time.Time
+}
+
+func newTicketClaims() *ticketClaims {
+	return &ticketClaims{
+		claims: map[string]*ticketClaim{},
+		active: map[*cycle]bool{},
+	}
+}
+
+// startCycle records that a cycle of p started.
+func (c *ticketClaims) startCycle(p *partition) *cycle {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cy := &cycle{partition: p, start: time.Now()}
+	c.active[cy] = true
+	return cy
+}
+
+// endCycle records that the cycle ended, dropping the claims no running cycle
+// needs anymore.
+func (c *ticketClaims) endCycle(cy *cycle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.active, cy)
+
+	var oldest time.Time
+	for a := range c.active {
+		if oldest.IsZero() || a.start.Before(oldest) {
+			oldest = a.start
+		}
+	}
+	for id, claim := range c.claims {
+		if claim.at.IsZero() {
+			continue
+		}
+		if oldest.IsZero() || claim.at.Before(oldest) {
+			delete(c.claims, id)
+		}
+	}
+}
+
+// claimed returns whether any of the tickets are claimed by another cycle.
+func (c *ticketClaims) claimed(cy *cycle, ids []string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.claimedLocked(cy, ids)
+}
+
+func (c *ticketClaims) claimedLocked(cy *cycle, ids []string) bool {
+	for _, id := range ids {
+		if claim, ok := c.claims[id]; ok && claim.owner != cy {
+			return true
+		}
+	}
+	return false
+}
+
+// reserve claims the tickets for the cycle, unless any of them are claimed
+// by another cycle.
+func (c *ticketClaims) reserve(cy *cycle, ids []string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.claimedLocked(cy, ids) {
+		return false
+	}
+	for _, id := range ids {
+		c.claims[id] = &ticketClaim{owner: cy}
+	}
+	return true
+}
+
+// confirm records that the reserved tickets were added to the pending release.
+func (c *ticketClaims) confirm(ids []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for _, id := range ids {
+		if claim, ok := c.claims[id]; ok {
+			claim.at = now
+		}
+	}
+}
+
+// release drops reserved tickets which failed to be added to the pending
+// release.
+func (c *ticketClaims) release(ids []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range ids {
+		if claim, ok := c.claims[id]; ok && claim.at.IsZero() {
+			delete(c.claims, id)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"open-match.dev/open-match/internal/ipb"
+	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
+)
+
+func partitionNames(s *synchronizerService) []string {
+	s.partitionsMu.Lock()
+	defer s.partitionsMu.Unlock()
+	var names []string
+	for name := range s.partitions {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestPartitionEviction(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("registrationInterval", 10*time.Millisecond)
+	cfg.Set("proposalCollectionInterval", 5*time.Second)
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	s := newSynchronizerService(cfg, releasedEvaluator{}, store)
+
+	// Partitions are evicted once no longer used.
+	a := s.partition("a")
+	require.Same(t, a, s.partition("a"))
+	s.releasePartition(a)
+	require.Equal(t, []string{"a"}, partitionNames(s))
+	s.releasePartition(a)
+	require.Empty(t, partitionNames(s))
+
+	// A partition with a running cycle is evicted once the cycle ended.
+	b := s.partition("b")
+	r := s.register(context.Background(), b)
+	s.releasePartition(b)
+	require.Equal(t, []string{"b"}, partitionNames(s))
+	r.allM1cSent.Done()
+	for range r.m7c {
+	}
+	require.Eventually(t, func() bool {
+		return len(partitionNames(s)) == 0
+	}, time.Second, time.Millisecond)
+	require.NotSame(t, b, s.partition("b"))
+}
+
+type fakeSynchronizeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ipb.SynchronizeResponse
+	recv chan *ipb.SynchronizeRequest
+}
+
+func (f *fakeSynchronizeStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeSynchronizeStream) Send(resp *ipb.SynchronizeResponse) error {
+	f.sent <- resp
+	return nil
+}
+
+func (f *fakeSynchronizeStream) Recv() (*ipb.SynchronizeRequest, error) {
+	req, ok := <-f.recv
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func TestSynchronizePartitionMetadata(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("registrationInterval", 10*time.Millisecond)
+	cfg.Set("proposalCollectionInterval", 10*time.Millisecond)
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	s := newSynchronizerService(cfg, releasedEvaluator{}, store)
+
+	for _, name := range []string{"", "ranked"} {
+		ctx := context.Background()
+		if name != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(partitionMetadata, name))
+		}
+		stream := &fakeSynchronizeStream{
+			ctx:  ctx,
+			sent: make(chan *ipb.SynchronizeResponse, 10),
+			recv: make(chan *ipb.SynchronizeRequest),
+		}
+		done := make(chan error)
+		go func() {
+			done <- s.Synchronize(stream)
+		}()
+
+		// Backends which predate partitions send nothing until the mmfs are started.
+		require.True(t, (<-stream.sent).StartMmfs)
+		require.Contains(t, partitionNames(s), name)
+		close(stream.recv)
+		require.NoError(t, <-done)
+	}
+}
+
+func TestTicketClaims(t *testing.T) {
+	p := &partition{name: "p"}
+	c := newTicketClaims()
+
+	a := c.startCycle(p)
+	b := c.startCycle(p)
+
+	require.True(t, c.reserve(a, []string{"1", "2"}))
+	require.False(t, c.reserve(b, []string{"2", "3"}))
+	require.True(t, c.claimed(b, []string{"2"}))
+	require.False(t, c.claimed(a, []string{"2"}))
+	require.True(t, c.reserve(b, []string{"3"}))
+	require.True(t, c.reserve(a, []string{"1"}))
+
+	// Claims which failed to be added to the pending release are dropped.
+	c.release([]string{"3"})
+	require.True(t, c.reserve(a, []string{"3"}))
+	c.confirm([]string{"1", "2", "3"})
+
+	// b may still have queried the tickets before they were pending.
+	c.endCycle(a)
+	require.False(t, c.reserve(b, []string{"1"}))
+
+	// Cycles starting now see the tickets as pending.
+	a = c.startCycle(p)
+	c.endCycle(b)
+	require.True(t, c.reserve(a, []string{"1"}))
+}
@@ -0,0 +1,238 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/statestore"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// matchQuotaExtension is the Match extension, a google.protobuf.StringValue,
+// naming the quota of the match.  It takes precedence over the profiles of
+// the quotas.
+const matchQuotaExtension = "match_quota"
+
+// matchQuota limits the matches of a cycle which count against it.
+type matchQuota struct {
+	name string
+	// maxMatches is the number of matches a cycle may return, 0 for no limit.
+	maxMatches int
+	// maxPoolFraction is the fraction of the tickets ready at the start of
+	// the cycle which its matches may take, 0 for no limit.
+	maxPoolFraction float64
+}
+
+// matchQuotas are the quotas configured under matchQuotas.quotas.  Each quota
+// is configured under matchQuotas.<name>, with its maxMatches and
+// maxPoolFraction, and the list of profiles whose matches count against it.
+type matchQuotas struct {
+	byName    map[string]*matchQuota
+	byProfile map[string]*matchQuota
+}
+
+// newMatchQuotas returns the configured quotas, or nil if there are none.
+func newMatchQuotas(cfg config.View) *matchQuotas {
+	names := cfg.GetStringSlice("matchQuotas.quotas")
+	if len(names) == 0 {
+		return nil
+	}
+
+	q := &matchQuotas{
+		byName:    map[string]*matchQuota{},
+		byProfile: map[string]*matchQuota{},
+	}
+	for _, name := range names {
+		prefix := "matchQuotas." + name
+		quota := &matchQuota{
+			name:            name,
+			maxMatches:      cfg.GetInt(prefix + ".maxMatches"),
+			maxPoolFraction: cfg.GetFloat64(prefix + ".maxPoolFraction"),
+		}
+		q.byName[name] = quota
+		for _, profile := range cfg.GetStringSlice(prefix + ".profiles") {
+			q.byProfile[profile] = quota
+		}
+	}
+	return q
+}
+
+// quotaOf returns the quota the match counts against, nil if none.
+func (q *matchQuotas) quotaOf(m *pb.Match) *matchQuota {
+	if q == nil {
+		return nil
+	}
+	if a, ok := m.GetExtensions()[matchQuotaExtension]; ok {
+		name := &wrappers.StringValue{}
+		if err := ptypes.UnmarshalAny(a, name); err != nil {
+			logger.WithError(err).Warningf("failed to unmarshal %s extension of MatchId %s", matchQuotaExtension, m.GetMatchId())
+		} else if quota, ok := q.byName[name.GetValue()]; ok {
+			return quota
+		}
+	}
+	return q.byProfile[m.GetMatchProfile()]
+}
+
+// cycleQuotas counts the matches a cycle returns against their quotas.
+type cycleQuotas struct {
+	mu      sync.Mutex
+	matches map[*matchQuota]int
+	tickets map[*matchQuota]int
+	// pool is the number of tickets ready at the start of the cycle.
+	pool int
+}
+
+// newCycleQuotas starts counting the matches of a cycle.  The ready tickets
+// are only counted when a quota limits the fraction of them taken.
+func newCycleQuotas(ctx context.Context, q *matchQuotas, store statestore.Service) *cycleQuotas {
+	c := &cycleQuotas{
+		matches: map[*matchQuota]int{},
+		tickets: map[*matchQuota]int{},
+		pool:    math.MaxInt32,
+	}
+	if !q.limitPool() {
+		return c
+	}
+
+	ids, err := store.GetIndexedIDSet(ctx)
+	if err != nil {
+		// Failing open keeps matchmaking going, the cycle fails anyway if the
+		// statestore is unavailable.
+		logger.WithError(err).Warning("failed to get the ready tickets for match quotas, not enforcing maxPoolFraction")
+		return c
+	}
+	c.pool = len(ids)
+	return c
+}
+
+func (q *matchQuotas) limitPool() bool {
+	if q == nil {
+		return false
+	}
+	for _, quota := range q.byName {
+		if quota.maxPoolFraction > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// allow counts a match with the given number of tickets against the quota,
+// and returns false, without counting it, if that would exceed the quota.
+func (c *cycleQuotas) allow(quota *matchQuota, tickets int) bool {
+	if quota == nil {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if quota.maxMatches > 0 && c.matches[quota] >= quota.maxMatches {
+		return false
+	}
+	if quota.maxPoolFraction > 0 && float64(c.tickets[quota]+tickets) > quota.maxPoolFraction*float64(c.pool) {
+		return false
+	}
+
+	c.matches[quota]++
+	c.tickets[quota] += tickets
+	return true
+}
+
+// quotaEvaluation applies the match quotas to the matches accepted by the
+// evaluator calls of a cycle.
+type quotaEvaluation struct {
+	quotas *cycleQuotas
+	// matches holds the pendingTickets of the proposals by match id.
+	matches *sync.Map
+	// seen holds the ids of the accepted matches, allowed the ids of those
+	// within their quotas.
+	seen    map[string]bool
+	allowed map[string]bool
+	// over holds the quotas which rejected a match.
+	over map[*matchQuota]bool
+}
+
+// allow passes the accepted matches within their quotas on to out, and
+// returns whether any were rejected.  The Synchronize call of a rejected match
+// is told about it.
+func (q *quotaEvaluation) allow(ctx context.Context, timeline *cycleTimeline, accepted <-chan string, out chan<- string) bool {
+	rejected := false
+	for mID := range accepted {
+		q.seen[mID] = true
+		if v, ok := q.matches.Load(mID); ok {
+			pt := v.(pendingTickets)
+			if !q.quotas.allow(pt.quota, len(pt.ids)) {
+				logger.Infof("rejecting MatchId %s of partition %s, it is over its match quota %s", mID, timeline.Partition, pt.quota.name)
+				stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(quotaKey, pt.quota.name)}, quotaRejections.M(1))
+				if pt.registration != nil {
+					pt.registration.rejectForQuota(mID)
+				}
+				q.over[pt.quota] = true
+				rejected = true
+				continue
+			}
+		}
+		q.allowed[mID] = true
+		out <- mID
+	}
+	return rejected
+}
+
+// remaining returns the proposals which were not accepted, and neither
+// collide with the allowed matches nor count against a quota which rejected
+// a match.
+func (q *quotaEvaluation) remaining(proposals []*pb.Match) []*pb.Match {
+	tickets := map[string]bool{}
+	backfills := map[string]bool{}
+	for _, m := range proposals {
+		if !q.allowed[m.GetMatchId()] {
+			continue
+		}
+		for _, t := range m.GetTickets() {
+			tickets[t.GetId()] = true
+		}
+		if id := m.GetBackfill().GetId(); id != "" {
+			backfills[id] = true
+		}
+	}
+
+	var remaining []*pb.Match
+Proposals:
+	for _, m := range proposals {
+		if q.seen[m.GetMatchId()] || backfills[m.GetBackfill().GetId()] {
+			continue
+		}
+		if v, ok := q.matches.Load(m.GetMatchId()); ok && q.over[v.(pendingTickets).quota] {
+			continue
+		}
+		for _, t := range m.GetTickets() {
+			if tickets[t.GetId()] {
+				continue Proposals
+			}
+		}
+		remaining = append(remaining, m)
+	}
+	return remaining
+}
@@ -0,0 +1,184 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestQuotaOf(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("matchQuotas.quotas", []string{"ranked", "casual"})
+	cfg.Set("matchQuotas.ranked.profiles", []string{"ranked-eu", "ranked-us"})
+	cfg.Set("matchQuotas.ranked.maxMatches", 20)
+	cfg.Set("matchQuotas.casual.maxPoolFraction", 0.5)
+	q := newMatchQuotas(cfg)
+
+	named, err := ptypes.MarshalAny(&wrappers.StringValue{Value: "casual"})
+	require.NoError(t, err)
+	unknown, err := ptypes.MarshalAny(&wrappers.StringValue{Value: "unknown"})
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name  string
+		match *pb.Match
+		want  string
+	}{
+		{"profile", &pb.Match{MatchProfile: "ranked-us"}, "ranked"},
+		{"no quota", &pb.Match{MatchProfile: "other"}, ""},
+		{"extension", &pb.Match{
+			MatchProfile: "ranked-us",
+			Extensions:   map[string]*any.Any{matchQuotaExtension: named},
+		}, "casual"},
+		{"unknown extension", &pb.Match{
+			MatchProfile: "ranked-eu",
+			Extensions:   map[string]*any.Any{matchQuotaExtension: unknown},
+		}, "ranked"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			quota := q.quotaOf(tt.match)
+			if tt.want == "" {
+				require.Nil(t, quota)
+				return
+			}
+			require.Equal(t, tt.want, quota.name)
+		})
+	}
+
+	require.Equal(t, 20, q.byName["ranked"].maxMatches)
+	require.Equal(t, 0.5, q.byName["casual"].maxPoolFraction)
+	require.Nil(t, newMatchQuotas(viper.New()))
+}
+
+func TestMatchQuotas(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("registrationInterval", 10*time.Millisecond)
+	cfg.Set("proposalCollectionInterval", 5*time.Second)
+	cfg.Set("matchQuotas.quotas", []string{"ranked", "casual"})
+	cfg.Set("matchQuotas.ranked.profiles", []string{"ranked"})
+	cfg.Set("matchQuotas.ranked.maxMatches", 1)
+	cfg.Set("matchQuotas.casual.profiles", []string{"casual"})
+	cfg.Set("matchQuotas.casual.maxPoolFraction", 0.5)
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+
+	ctx := context.Background()
+	for _, id := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		require.NoError(t, store.CreateTicket(ctx, &pb.Ticket{Id: id}))
+		require.NoError(t, store.IndexTicket(ctx, &pb.Ticket{Id: id}))
+	}
+
+	proposals := []*pb.Match{
+		{MatchId: "r1", MatchProfile: "ranked", Tickets: []*pb.Ticket{{Id: "a"}}},
+		{MatchId: "r2", MatchProfile: "ranked", Tickets: []*pb.Ticket{{Id: "b"}}},
+		{MatchId: "c1", MatchProfile: "casual", Tickets: []*pb.Ticket{{Id: "c"}, {Id: "d"}, {Id: "e"}}},
+		{MatchId: "c2", MatchProfile: "casual", Tickets: []*pb.Ticket{{Id: "f"}, {Id: "g"}}},
+		{MatchId: "c3", MatchProfile: "casual", Tickets: []*pb.Ticket{{Id: "h"}}},
+		{MatchId: "o1", MatchProfile: "other", Tickets: []*pb.Ticket{{Id: "i"}}},
+	}
+	eval := releasedEvaluator{}
+	for _, m := range proposals {
+		eval[m.GetMatchId()] = make(chan struct{})
+		close(eval[m.GetMatchId()])
+	}
+
+	s := newSynchronizerService(cfg, eval, store)
+	r := s.register(ctx, s.partition(""))
+	for _, m := range proposals {
+		r.m1c.send(mAndM7c{m: m, m7c: r.m7c, registration: r})
+	}
+	r.allM1cSent.Done()
+
+	var ids []string
+	for id := range r.m7c {
+		ids = append(ids, id)
+	}
+	// Half of the 8 ready tickets fit the casual quota.
+	require.ElementsMatch(t, []string{"r1", "c1", "c3", "o1"}, ids)
+	require.ElementsMatch(t, []string{"r2", "c2"}, r.quotaRejectedMatchIds())
+
+	// The tickets of rejected matches remain available to later cycles.
+	require.False(t, s.claims.claimed(nil, []string{"b", "f", "g"}))
+}
+
+// firstWinsEvaluator accepts the proposals which don't collide with a proposal
+// accepted before them.
+type firstWinsEvaluator struct{}
+
+func (firstWinsEvaluator) evaluate(ctx context.Context, in <-chan []*pb.Match, out chan<- string) error {
+	used := map[string]bool{}
+	var ids []string
+Proposals:
+	for ms := range in {
+		for _, m := range ms {
+			for _, t := range m.GetTickets() {
+				if used[t.GetId()] {
+					continue Proposals
+				}
+			}
+			for _, t := range m.GetTickets() {
+				used[t.GetId()] = true
+			}
+			ids = append(ids, m.GetMatchId())
+		}
+	}
+	for _, id := range ids {
+		out <- id
+	}
+	return nil
+}
+
+func TestMatchQuotasReplaceRejectedMatches(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("registrationInterval", 10*time.Millisecond)
+	cfg.Set("proposalCollectionInterval", 5*time.Second)
+	cfg.Set("matchQuotas.quotas", []string{"ranked"})
+	cfg.Set("matchQuotas.ranked.profiles", []string{"ranked"})
+	cfg.Set("matchQuotas.ranked.maxMatches", 1)
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+
+	s := newSynchronizerService(cfg, firstWinsEvaluator{}, store)
+	r := s.register(context.Background(), s.partition(""))
+	// The evaluator discards o1 in favor of r2, which is over the quota, so o1
+	// takes its place once r2 is rejected.
+	for _, m := range []*pb.Match{
+		{MatchId: "r1", MatchProfile: "ranked", Tickets: []*pb.Ticket{{Id: "a"}}},
+		{MatchId: "r2", MatchProfile: "ranked", Tickets: []*pb.Ticket{{Id: "b"}}},
+		{MatchId: "o1", MatchProfile: "other", Tickets: []*pb.Ticket{{Id: "b"}, {Id: "c"}}},
+		{MatchId: "o2", MatchProfile: "other", Tickets: []*pb.Ticket{{Id: "a"}, {Id: "d"}}},
+	} {
+		r.m1c.send(mAndM7c{m: m, m7c: r.m7c, registration: r})
+	}
+	r.allM1cSent.Done()
+
+	var ids []string
+	for id := range r.m7c {
+		ids = append(ids, id)
+	}
+	require.ElementsMatch(t, []string{"r1", "o1"}, ids)
+	require.Equal(t, []string{"r2"}, r.quotaRejectedMatchIds())
+}
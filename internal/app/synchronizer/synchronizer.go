@@ -17,6 +17,7 @@ package synchronizer
 import (
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 	"google.golang.org/grpc"
 	"open-match.dev/open-match/internal/appmain"
 	"open-match.dev/open-match/internal/ipb"
@@ -25,27 +26,54 @@ import (
 )
 
 var (
+	partitionKey = tag.MustNewKey("partition")
+	fallbackKey  = tag.MustNewKey("fallback")
+	quotaKey     = tag.MustNewKey("quota")
+	// registrationWindowKey and proposalWindowKey tag iterations with the
+	// upper bound of the bucket their window falls in, in milliseconds.
+	registrationWindowKey = tag.MustNewKey("registration_window")
+	proposalWindowKey     = tag.MustNewKey("proposal_collection_window")
+
 	iterationLatency        = stats.Float64("open-match.dev/synchronizer/iteration_latency", "Time elapsed of each synchronizer iteration", stats.UnitMilliseconds)
 	registrationWaitTime    = stats.Float64("open-match.dev/synchronizer/registration_wait_time", "Time elapsed of registration wait time", stats.UnitMilliseconds)
 	registrationMMFDoneTime = stats.Float64("open-match.dev/synchronizer/registration_mmf_done_time", "Time elapsed wasted in registration window with done MMFs", stats.UnitMilliseconds)
+	evaluatorFallbacks      = stats.Int64("open-match.dev/synchronizer/evaluator_fallbacks", "Number of failed evaluator calls handled by the fallback policy", stats.UnitDimensionless)
+	quotaRejections         = stats.Int64("open-match.dev/synchronizer/quota_rejections", "Number of accepted matches rejected by their match quota", stats.UnitDimensionless)
 
 	iterationLatencyView = &view.View{
 		Measure:     iterationLatency,
 		Name:        "open-match.dev/synchronizer/iteration_latency",
 		Description: "Time elapsed of each synchronizer iteration",
 		Aggregation: telemetry.DefaultMillisecondsDistribution,
+		TagKeys:     []tag.Key{partitionKey, registrationWindowKey, proposalWindowKey},
 	}
 	registrationWaitTimeView = &view.View{
 		Measure:     registrationWaitTime,
 		Name:        "open-match.dev/synchronizer/registration_wait_time",
 		Description: "Time elapsed of registration wait time",
 		Aggregation: telemetry.DefaultMillisecondsDistribution,
+		TagKeys:     []tag.Key{partitionKey},
 	}
 	registrationMMFDoneTimeView = &view.View{
 		Measure:     registrationMMFDoneTime,
 		Name:        "open-match.dev/synchronizer/registration_mmf_done_time",
 		Description: "Time elapsed wasted in registration window with done MMFs",
 		Aggregation: telemetry.DefaultMillisecondsDistribution,
+		TagKeys:     []tag.Key{partitionKey},
+	}
+	evaluatorFallbacksView = &view.View{
+		Measure:     evaluatorFallbacks,
+		Name:        "open-match.dev/synchronizer/evaluator_fallbacks",
+		Description: "Number of failed evaluator calls handled by the fallback policy",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{partitionKey, fallbackKey},
+	}
+	quotaRejectionsView = &view.View{
+		Measure:     quotaRejections,
+		Name:        "open-match.dev/synchronizer/quota_rejections",
+		Description: "Number of accepted matches rejected by their match quota",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{partitionKey, quotaKey},
 	}
 )
 
@@ -57,10 +85,17 @@ func BindService(p *appmain.Params, b *appmain.Bindings) error {
 	b.AddHandleFunc(func(s *grpc.Server) {
 		ipb.RegisterSynchronizerServer(s, service)
 	}, nil)
+	b.AddCloser(service.close)
+	if p.Config().GetBool("telemetry.zpages.enable") {
+		b.TelemetryHandle(cyclezEndpoint, service.history)
+		b.TelemetryHandleFunc(cyclezJSONEndpoint, service.history.serveJSON)
+	}
 	b.RegisterViews(
 		iterationLatencyView,
 		registrationWaitTimeView,
 		registrationMMFDoneTimeView,
+		evaluatorFallbacksView,
+		quotaRejectionsView,
 	)
 	return nil
 }
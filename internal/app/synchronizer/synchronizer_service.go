@@ -19,11 +19,17 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/app/evaluator/defaulteval"
 	"open-match.dev/open-match/internal/appmain/contextcause"
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/ipb"
@@ -61,40 +67,105 @@ var (
 //   -> (Synchronize call specific ) m7c -> (buffered)
 // return to backend                     | Synchronize
 
-type synchronizerService struct {
-	cfg   config.View
-	store statestore.Service
-	eval  evaluator
+// Dry run proposals take a separate path from cacheMatchIDToTicketIDs: they
+// are sent to their own evaluator call (wrapDryRunEvaluator), so that they can
+// never displace other proposals, and addMatchesToPendingRelease passes them
+// on to m6c without adding their tickets to the pending release.
 
-	synchronizeRegistration chan *registrationRequest
+// Each synchronization partition runs this pipeline in its own cycles, which
+// run concurrently with the cycles of other partitions.  With pipelinedCycles
+// set, the next cycle of a partition also starts once the previous one has
+// collected its proposals.  Proposals with tickets which a concurrent cycle
+// just added to the pending release are rejected by cacheMatchIDToTicketIDs,
+// or dropped by addMatchesToPendingRelease if added after evaluation.
 
-	// startCycle is a buffered channel for containing a single value.  The value
-	// is present only when a cycle is not running.
-	startCycle chan struct{}
+type synchronizerService struct {
+	cfg    config.View
+	store  statestore.Service
+	eval   evaluator
+	quotas *matchQuotas
+
+	// defaultEval evaluates the remaining proposals of failed evaluator calls
+	// when evaluatorFallback is defaulteval.
+	defaultEval func(context.Context, <-chan *pb.Match, chan<- string) error
+
+	// partitions holds the partitions in use by name, each running its own
+	// cycles.
+	partitionsMu sync.Mutex
+	partitions   map[string]*partition
+	claims       *ticketClaims
+
+	// cycles tracks the running cycles, which may outlive their hold of the
+	// partition's startCycle when pipelinedCycles is set.
+	cycles sync.WaitGroup
+
+	// history keeps the timelines of recent cycles for the /cyclez endpoint.
+	history *cycleHistory
+
+	// leader elects the replica running cycles when
+	// synchronizerLeaderElection.enabled is set, nil otherwise.  Other
+	// replicas proxy Synchronize calls to the leader.
+	leader *leaderElector
 }
 
 func newSynchronizerService(cfg config.View, eval evaluator, store statestore.Service) *synchronizerService {
 	s := &synchronizerService{
-		cfg:   cfg,
-		store: store,
-		eval:  eval,
+		cfg:    cfg,
+		store:  store,
+		eval:   eval,
+		quotas: newMatchQuotas(cfg),
+
+		defaultEval: defaulteval.New(cfg),
 
-		synchronizeRegistration: make(chan *registrationRequest),
-		startCycle:              make(chan struct{}, 1),
+		partitions: map[string]*partition{},
+		claims:     newTicketClaims(),
+		history:    newCycleHistory(cycleHistorySize),
 	}
 
-	s.startCycle <- struct{}{}
+	if cfg.GetBool("synchronizerLeaderElection.enabled") {
+		s.leader = newLeaderElector(cfg, store)
+	}
 
 	return s
 }
 
+// close hands over the leadership, if held, once the cycles in flight are done.
+func (s *synchronizerService) close() {
+	if s.leader == nil {
+		return
+	}
+	s.leader.stepDown()
+
+	s.partitionsMu.Lock()
+	defer s.partitionsMu.Unlock()
+	for _, p := range s.partitions {
+		<-p.startCycle
+	}
+	s.cycles.Wait()
+	s.leader.close()
+	for _, p := range s.partitions {
+		p.startCycle <- struct{}{}
+	}
+}
+
 func (s *synchronizerService) Synchronize(stream ipb.Synchronizer_SynchronizeServer) error {
-	// Synchronize first registers against a cycle.  Then it creates two go
-	// routines:
+	// Synchronize first registers against a cycle of the partition sent in
+	// the metadata.  Then it creates two go routines:
 	// 1. Receive proposals from backend, send them to cycle.
 	// 2. Receive matches and signals from cycle, send them to backend.
 
-	registration := s.register(stream.Context())
+	if s.leader != nil {
+		if _, ok := s.leader.term(); !ok {
+			return s.leader.proxy(stream)
+		}
+	}
+
+	p := s.partition(streamPartition(stream.Context()))
+	defer s.releasePartition(p)
+	registration := s.register(stream.Context(), p)
+	if registration == nil {
+		return status.Error(codes.Unavailable, "synchronizer lost its leadership")
+	}
 	m6cBuffer := bufferStringChannel(registration.m7c)
 	defer func() {
 		for range m6cBuffer {
@@ -113,7 +184,14 @@ func (s *synchronizerService) Synchronize(stream ipb.Synchronizer_SynchronizeSer
 				registration.allM1cSent.Done()
 				return
 			}
-			registration.m1c.send(mAndM7c{m: req.Proposal, m7c: registration.m7c})
+			atomic.AddInt64(&registration.proposals, 1)
+			registration.m1c.send(mAndM7c{
+				m:                     req.Proposal,
+				m7c:                   registration.m7c,
+				registration:          registration,
+				dryRun:                req.DryRun,
+				pendingReleaseTimeout: pendingReleaseTimeout(req),
+			})
 		}
 	}()
 
@@ -130,7 +208,19 @@ func (s *synchronizerService) Synchronize(stream ipb.Synchronizer_SynchronizeSer
 				// closed as part of cleanup.  If it's especially fast, it may
 				// beat the context done case, so be sure to return any
 				// potential error.
-				return registration.cycleCtx.Err()
+				if err := registration.cycleCtx.Err(); err != nil {
+					return err
+				}
+				for _, mID := range registration.quotaRejectedMatchIds() {
+					err = stream.Send(&ipb.SynchronizeResponse{QuotaRejectedMatchId: mID})
+					if err != nil {
+						logger.WithFields(logrus.Fields{
+							"error": err.Error(),
+						}).Error("error streaming quota rejected match in synchronizer to backend")
+						return err
+					}
+				}
+				return nil
 			}
 			for _, mID := range mIDs {
 				err = stream.Send(&ipb.SynchronizeResponse{MatchId: mID})
@@ -176,29 +266,68 @@ type registrationRequest struct {
 }
 
 type registration struct {
+	// proposals counts the proposals of the Synchronize call, only accessed
+	// atomically.
+	proposals  int64
 	m1c        *cutoffSender
 	allM1cSent *sync.WaitGroup
 	m7c        chan string
 	cancelMmfs chan struct{}
 	cycleCtx   context.Context
+
+	// quotaRejected holds the ids of the accepted matches of the Synchronize
+	// call which were rejected by their match quota.
+	quotaRejectedMu sync.Mutex
+	quotaRejected   []string
+}
+
+func (r *registration) rejectForQuota(mID string) {
+	r.quotaRejectedMu.Lock()
+	defer r.quotaRejectedMu.Unlock()
+	r.quotaRejected = append(r.quotaRejected, mID)
+}
+
+func (r *registration) quotaRejectedMatchIds() []string {
+	r.quotaRejectedMu.Lock()
+	defer r.quotaRejectedMu.Unlock()
+	return r.quotaRejected
 }
 
-func (s synchronizerService) register(ctx context.Context) *registration {
+func (s *synchronizerService) register(ctx context.Context, p *partition) *registration {
 	req := &registrationRequest{
 		resp: make(chan *registration),
 		ctx:  ctx,
 	}
 
 	st := time.Now()
-	defer stats.Record(ctx, registrationWaitTime.M(float64(time.Since(st))/float64(time.Millisecond)))
+	defer func() {
+		stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(partitionKey, p.name)}, registrationWaitTime.M(float64(time.Since(st))/float64(time.Millisecond)))
+	}()
+	if p.windows != nil {
+		atomic.AddInt64(&p.windows.waiting, 1)
+		defer atomic.AddInt64(&p.windows.waiting, -1)
+	}
 	for {
 		select {
-		case s.synchronizeRegistration <- req:
+		case p.synchronizeRegistration <- req:
 			return <-req.resp
-		case <-s.startCycle:
+		case <-p.startCycle:
+			if s.leader != nil {
+				if _, ok := s.leader.term(); !ok {
+					// Leadership was lost, the caller must go to the new leader.
+					p.startCycle <- struct{}{}
+					return nil
+				}
+			}
+			s.cycles.Add(1)
 			go func() {
-				s.runCycle()
-				s.startCycle <- struct{}{}
+				s.runCycle(p, func() {
+					p.startCycle <- struct{}{}
+				})
+				s.cycles.Done()
+				// After Done, as close holds partitionsMu while waiting for
+				// the cycles.
+				s.evictIdle(p)
 			}()
 		}
 	}
@@ -207,15 +336,40 @@ func (s synchronizerService) register(ctx context.Context) *registration {
 ///////////////////////////////////////
 ///////////////////////////////////////
 
-func (s *synchronizerService) runCycle() {
+// runCycle runs a cycle of the partition, calling startNext once the next
+// cycle of the partition can start.  That is when this cycle ends, or with
+// pipelinedCycles set, as soon as this cycle has collected its proposals, so
+// that the next cycle runs its MMFs while this one is evaluating.  Matches
+// with tickets added to the pending release by a concurrent cycle are
+// rejected.
+func (s *synchronizerService) runCycle(p *partition, startNext func()) {
+	var startNextOnce sync.Once
+	defer startNextOnce.Do(startNext)
+
 	cst := time.Now()
 	/////////////////////////////////////// Initialize cycle
 	ctx, cancel := contextcause.WithCancelCause(context.Background())
+	statsCtx, err := tag.New(ctx, tag.Upsert(partitionKey, p.name))
+	if err != nil {
+		logger.WithError(err).Warningf("failed to tag metrics with partition %s", p.name)
+		statsCtx = ctx
+	}
+
+	cy := s.claims.startCycle(p)
+	defer s.claims.endCycle(cy)
+	quotas := newCycleQuotas(ctx, s.quotas, s.store)
+
+	timeline := &cycleTimeline{Start: cst, Partition: p.name}
+	// collectedAt is the UnixNano time proposal collection ended, only accessed
+	// atomically.
+	var collectedAt int64
 
 	m2c := make(chan mAndM7c)
-	m3c := make(chan *pb.Match)
+	m3c := make(chan mAndM7c)
 	m4c := make(chan *pb.Match)
+	dryRunM4c := make(chan *pb.Match)
 	m5c := make(chan string)
+	dryRunM5c := make(chan string)
 	m6c := make(chan string)
 
 	m1c := newCutoffSender(m2c)
@@ -236,23 +390,55 @@ func (s *synchronizerService) runCycle() {
 		}
 	}()
 
+	if s.leader != nil {
+		// Stop the cycle as soon as another replica may have taken over, so
+		// that two cycles never add tickets to the pending release at once.
+		termCtx, ok := s.leader.term()
+		if !ok {
+			cancel(fmt.Errorf("canceled because the synchronizer is no longer the leader"))
+		} else {
+			go func() {
+				select {
+				case <-termCtx.Done():
+					cancel(fmt.Errorf("canceled because the synchronizer lost its leadership"))
+				case <-closedOnCycleEnd:
+				}
+			}()
+		}
+	}
+
 	matchTickets := &sync.Map{}
-	go s.cacheMatchIDToTicketIDs(matchTickets, m3c, m4c)
-	go s.wrapEvaluator(ctx, cancel, bufferMatchChannel(m4c), m5c)
+	go s.cacheMatchIDToTicketIDs(cy, timeline, matchTickets, m3c, m4c, dryRunM4c)
+	go s.wrapEvaluator(statsCtx, cancel, timeline, quotas, matchTickets, bufferMatchChannel(m4c), m5c)
+	go s.wrapDryRunEvaluator(ctx, bufferMatchChannel(dryRunM4c), dryRunM5c)
 	go func() {
-		s.addMatchesToPendingRelease(ctx, matchTickets, cancel, bufferStringChannel(m5c), m6c)
+		s.addMatchesToPendingRelease(ctx, cy, timeline, matchTickets, cancel, bufferStringChannel(m5c), bufferStringChannel(dryRunM5c), m6c)
 		// Wait for pending release, but not all matches returned, the next cycle
 		// can start now.
 		close(closedOnCycleEnd)
 	}()
 
+	go func() {
+		select {
+		case <-m1c.closed:
+			atomic.StoreInt64(&collectedAt, time.Now().UnixNano())
+			if s.cfg.GetBool("pipelinedCycles") {
+				startNextOnce.Do(startNext)
+			}
+		case <-closedOnCycleEnd:
+		}
+	}()
+
 	/////////////////////////////////////// Run Registration Period
+	registrationWindow, proposalWindow := s.cycleWindows(p)
+
+	var lastRegistration time.Duration
 	rst := time.Now()
-	closeRegistration := time.After(s.registrationInterval())
+	closeRegistration := time.After(registrationWindow)
 Registration:
 	for {
 		select {
-		case req := <-s.synchronizeRegistration:
+		case req := <-p.synchronizeRegistration:
 			allM1cSent.Add(1)
 			callingCtx = append(callingCtx, req.ctx)
 			r := &registration{
@@ -264,11 +450,19 @@ Registration:
 			}
 			registrations = append(registrations, r)
 			req.resp <- r
+			lastRegistration = time.Since(rst)
 		case <-closeRegistration:
 			break Registration
 		}
 	}
 	/////////////////////////////////////// Wait for cycle completion.
+	timeline.RegistrationDuration = time.Since(rst)
+	timeline.Registrations = len(registrations)
+
+	var queueDepth int64
+	if p.windows != nil {
+		queueDepth = p.windows.queueDepth()
+	}
 
 	go func() {
 		for _, ctx := range callingCtx {
@@ -277,13 +471,18 @@ Registration:
 		cancel(fmt.Errorf("canceled because all callers were done"))
 	}()
 
+	pst := time.Now()
+	mmfsDone := make(chan time.Duration, 1)
 	go func() {
 		allM1cSent.Wait()
+		mmfsDone <- time.Since(pst)
 		m1c.cutoff()
-		stats.Record(ctx, registrationMMFDoneTime.M(float64((s.registrationInterval()-time.Since(rst))/time.Millisecond)))
+		stats.Record(statsCtx, registrationMMFDoneTime.M(float64((registrationWindow-time.Since(rst))/time.Millisecond)))
 	}()
 
-	cancelProposalCollection := time.AfterFunc(s.proposalCollectionInterval(), func() {
+	proposalsCutOff := make(chan struct{})
+	cancelProposalCollection := time.AfterFunc(proposalWindow, func() {
+		close(proposalsCutOff)
 		m1c.cutoff()
 		for _, r := range registrations {
 			r.cancelMmfs <- struct{}{}
@@ -292,12 +491,50 @@ Registration:
 
 	<-closedOnCycleEnd
 
-	stats.Record(ctx, iterationLatency.M(float64(time.Since(cst)/time.Millisecond)))
+	stats.RecordWithTags(statsCtx, []tag.Mutator{
+		tag.Upsert(registrationWindowKey, windowBucket(registrationWindow)),
+		tag.Upsert(proposalWindowKey, windowBucket(proposalWindow)),
+	}, iterationLatency.M(float64(time.Since(cst)/time.Millisecond)))
 
 	// Clean up in case it was never needed.
 	cancelProposalCollection.Stop()
 
-	err := s.store.CleanupBackfills(ctx)
+	timeline.Duration = time.Since(cst)
+	for _, r := range registrations {
+		timeline.Proposals = append(timeline.Proposals, atomic.LoadInt64(&r.proposals))
+	}
+	if collected := atomic.LoadInt64(&collectedAt); collected != 0 {
+		timeline.ProposalCollectionDuration = time.Unix(0, collected).Sub(pst)
+		if !timeline.evaluatorDone.IsZero() {
+			timeline.EvaluatorLatency = timeline.evaluatorDone.Sub(time.Unix(0, collected))
+		}
+	}
+	timeline.Rejected = int(atomic.LoadInt64(&timeline.proposed)) - timeline.Accepted
+	if err := ctx.Err(); err != nil {
+		timeline.CancelCause = err.Error()
+	}
+	s.history.add(timeline)
+
+	if p.windows != nil {
+		o := cycleObservation{
+			registrations:    len(registrations),
+			lastRegistration: lastRegistration,
+			queueDepth:       queueDepth,
+		}
+		select {
+		case d := <-mmfsDone:
+			o.mmfsDone = d
+			p.windows.observe(o)
+		case <-proposalsCutOff:
+			o.cutOff = true
+			p.windows.observe(o)
+		default:
+			// The cycle ended early, eg. because it was canceled, so there is
+			// nothing to learn about the MMFs.
+		}
+	}
+
+	err = s.store.CleanupBackfills(ctx)
 	if err != nil {
 		logger.Errorf("Failed to clean up backfills, %s", err.Error())
 	}
@@ -307,8 +544,27 @@ Registration:
 ///////////////////////////////////////
 
 type mAndM7c struct {
-	m   *pb.Match
-	m7c chan string
+	m                     *pb.Match
+	m7c                   chan string
+	dryRun                bool
+	pendingReleaseTimeout time.Duration
+	// registration is the Synchronize call of the match, told about quota
+	// rejections.  May be nil.
+	registration *registration
+}
+
+// pendingReleaseTimeout returns the pending release timeout of the proposal's
+// profile, or zero to use the configured one.
+func pendingReleaseTimeout(req *ipb.SynchronizeRequest) time.Duration {
+	if req.GetPendingReleaseTimeout() == nil {
+		return 0
+	}
+	d, err := ptypes.Duration(req.GetPendingReleaseTimeout())
+	if err != nil {
+		logger.WithError(err).Warning("invalid pending release timeout, using the configured one")
+		return 0
+	}
+	return d
 }
 
 // fanInFanOut routes evaluated matches back to it's source synchronize call.
@@ -316,7 +572,7 @@ type mAndM7c struct {
 // This channel is remembered in a map, and the match is passed to be evaluated.
 // When a match returns from evaluation, it's ID is looked up in the map and the
 // match is returned on that channel.
-func fanInFanOut(m2c <-chan mAndM7c, m3c chan<- *pb.Match, m6c <-chan string) {
+func fanInFanOut(m2c <-chan mAndM7c, m3c chan<- mAndM7c, m6c <-chan string) {
 	m7cMap := make(map[string]chan<- string)
 
 	defer func(m2c <-chan mAndM7c) {
@@ -329,7 +585,7 @@ func fanInFanOut(m2c <-chan mAndM7c, m3c chan<- *pb.Match, m6c <-chan string) {
 		case m2, ok := <-m2c:
 			if ok {
 				m7cMap[m2.m.GetMatchId()] = m2.m7c
-				m3c <- m2.m
+				m3c <- m2
 			} else {
 				close(m3c)
 				// No longer select on m2c
@@ -407,27 +663,232 @@ func (c *cutoffSender) cutoff() {
 ///////////////////////////////////////
 ///////////////////////////////////////
 
-// Calls the evaluator with the matches.
-func (s *synchronizerService) wrapEvaluator(ctx context.Context, cancel contextcause.CancelErrFunc, m4c <-chan []*pb.Match, m5c chan<- string) {
-	err := s.eval.evaluate(ctx, m4c, m5c)
-	if err != nil {
+// Calls the evaluator with the matches, and applies the match quotas to the
+// accepted ones.  Accepted matches over their quota are rejected, and the
+// evaluator is called again with the proposals which no longer collide with
+// the matches within their quotas, leaving out those of the quotas which
+// rejected a match, so that alternatives the evaluator discarded in favor of
+// the rejected matches can take their place.
+func (s *synchronizerService) wrapEvaluator(ctx context.Context, cancel contextcause.CancelErrFunc, timeline *cycleTimeline, quotas *cycleQuotas, m *sync.Map, m4c <-chan []*pb.Match, m5c chan<- string) {
+	defer close(m5c)
+
+	if s.quotas == nil {
+		s.evaluate(ctx, cancel, timeline, m4c, m5c)
+		return
+	}
+
+	accepted := make(chan string)
+	var proposals []*pb.Match
+	go func() {
+		proposals = s.evaluate(ctx, cancel, timeline, m4c, accepted)
+		close(accepted)
+	}()
+	q := &quotaEvaluation{
+		quotas:  quotas,
+		matches: m,
+		seen:    map[string]bool{},
+		allowed: map[string]bool{},
+		over:    map[*matchQuota]bool{},
+	}
+	rejected := q.allow(ctx, timeline, accepted, m5c)
+
+	for rejected && ctx.Err() == nil {
+		remaining := q.remaining(proposals)
+		if len(remaining) == 0 {
+			return
+		}
+
+		in := make(chan []*pb.Match, 1)
+		in <- remaining
+		close(in)
+		proposalsDone := make(chan struct{})
+		close(proposalsDone)
+		evalCtx, cancelEval, _ := s.evaluatorContext(ctx, proposalsDone)
+
+		var err error
+		out := make(chan string)
+		go func() {
+			err = s.eval.evaluate(evalCtx, in, out)
+			close(out)
+		}()
+		rejected = q.allow(ctx, timeline, out, m5c)
+		cancelEval()
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"error": err,
+			}).Error("error calling evaluator for the proposals replacing matches over their match quota")
+			return
+		}
+	}
+}
+
+// Calls the evaluator with the matches.  If the call fails, or does not return
+// within evaluatorTimeout, the evaluatorFallback policy decides whether the
+// cycle is canceled, or the matches accepted so far are kept and the rest
+// evaluated by the default evaluator or rejected.  It returns the proposals.
+func (s *synchronizerService) evaluate(ctx context.Context, cancel contextcause.CancelErrFunc, timeline *cycleTimeline, m4c <-chan []*pb.Match, m5c chan<- string) []*pb.Match {
+
+	// The proposals are kept for the fallback evaluator, which needs all of
+	// them even when the evaluator stopped reading early.  They are queued so
+	// that proposalsDone is closed once all are collected, however slow the
+	// evaluator is to read them.
+	var proposals []*pb.Match
+	in := make(chan []*pb.Match)
+	evalDone := make(chan struct{})
+	proposalsDone := make(chan struct{})
+	go func() {
+		defer close(in)
+		var queue [][]*pb.Match
+		stopped := evalDone
+		for m4c != nil || len(queue) > 0 {
+			var send chan<- []*pb.Match
+			var next []*pb.Match
+			if len(queue) > 0 {
+				send, next = in, queue[0]
+			}
+			select {
+			case ms, ok := <-m4c:
+				if !ok {
+					m4c = nil
+					close(proposalsDone)
+					continue
+				}
+				proposals = append(proposals, ms...)
+				if stopped != nil {
+					queue = append(queue, ms)
+				}
+			case send <- next:
+				queue = queue[1:]
+			case <-stopped:
+				stopped, queue = nil, nil
+			}
+		}
+	}()
+
+	evalCtx, cancelEval, timedOut := s.evaluatorContext(ctx, proposalsDone)
+	defer cancelEval()
+
+	var err error
+	out := make(chan string)
+	go func() {
+		err = s.eval.evaluate(evalCtx, in, out)
+		close(out)
+	}()
+	accepted := map[string]bool{}
+	for id := range out {
+		accepted[id] = true
+		m5c <- id
+	}
+	close(evalDone)
+	defer func() {
+		timeline.evaluatorDone = time.Now()
+	}()
+
+	if err == nil {
+		<-proposalsDone
+		return proposals
+	}
+	if timedOut() {
+		err = status.Errorf(codes.DeadlineExceeded, "evaluator did not return within %s of the last proposal: %s", s.evaluatorTimeout(), err.Error())
+	}
+
+	policy := evaluatorFallback(s.cfg)
+	if ctx.Err() != nil || policy == evaluatorFallbackCancel {
 		logger.WithFields(logrus.Fields{
 			"error": err,
 		}).Error("error calling evaluator, canceling cycle")
 		cancel(fmt.Errorf("error calling evaluator: %w", err))
+		<-proposalsDone
+		return proposals
+	}
+
+	logger.WithFields(logrus.Fields{
+		"error":    err,
+		"fallback": policy,
+		"accepted": len(accepted),
+	}).Error("error calling evaluator, falling back")
+	stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(fallbackKey, policy)}, evaluatorFallbacks.M(1))
+
+	<-proposalsDone
+	if policy == evaluatorFallbackDefault {
+		if err = evaluateWithDefault(ctx, s.defaultEval, proposals, accepted, m5c); err != nil {
+			cancel(fmt.Errorf("error calling fallback evaluator: %w", err))
+		}
+	}
+	return proposals
+}
+
+// Calls the evaluator with the dry run proposals, if there are any.  An error
+// only drops the dry run matches, it does not cancel the cycle.
+func (s *synchronizerService) wrapDryRunEvaluator(ctx context.Context, m4c <-chan []*pb.Match, m5c chan<- string) {
+	defer close(m5c)
+
+	// Most cycles have no dry run proposals, don't call the evaluator for those.
+	first, ok := <-m4c
+	if !ok {
+		return
+	}
+
+	proposals := make(chan []*pb.Match)
+	proposalsDone := make(chan struct{})
+	go func() {
+		defer close(proposalsDone)
+		defer close(proposals)
+		proposals <- first
+		for p := range m4c {
+			proposals <- p
+		}
+	}()
+
+	evalCtx, cancelEval, _ := s.evaluatorContext(ctx, proposalsDone)
+	defer cancelEval()
+	err := s.eval.evaluate(evalCtx, proposals, m5c)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"error": err,
+		}).Error("error calling evaluator for dry run proposals, dropping them")
+	}
+	for range proposals {
 	}
-	close(m5c)
 }
 
 ///////////////////////////////////////
 ///////////////////////////////////////
 
-func (s *synchronizerService) cacheMatchIDToTicketIDs(m *sync.Map, m3c <-chan *pb.Match, m4c chan<- *pb.Match) {
-	for match := range m3c {
-		m.Store(match.GetMatchId(), getTicketIds(match.GetTickets()))
-		m4c <- match
+func (s *synchronizerService) cacheMatchIDToTicketIDs(cy *cycle, timeline *cycleTimeline, m *sync.Map, m3c <-chan mAndM7c, m4c chan<- *pb.Match, dryRunM4c chan<- *pb.Match) {
+	for m3 := range m3c {
+		if m3.dryRun {
+			dryRunM4c <- m3.m
+			continue
+		}
+		atomic.AddInt64(&timeline.proposed, 1)
+		ids := getTicketIds(m3.m.GetTickets())
+		// Proposals with tickets a concurrent cycle has already matched are
+		// rejected before evaluation, so that they can't displace others.
+		if s.claims.claimed(cy, ids) {
+			logger.Debugf("rejecting MatchId %s, its tickets were matched by a concurrent cycle", m3.m.GetMatchId())
+			continue
+		}
+		m.Store(m3.m.GetMatchId(), pendingTickets{
+			ids:          ids,
+			timeout:      m3.pendingReleaseTimeout,
+			quota:        s.quotas.quotaOf(m3.m),
+			registration: m3.registration,
+		})
+		m4c <- m3.m
 	}
 	close(m4c)
+	close(dryRunM4c)
+}
+
+// pendingTickets are the tickets of a proposal, to be added to the pending
+// release with the timeout of the proposal's profile once it is accepted and
+// within its match quota.
+type pendingTickets struct {
+	ids          []string
+	timeout      time.Duration
+	quota        *matchQuota
+	registration *registration
 }
 
 func getTicketIds(tickets []*pb.Ticket) []string {
@@ -444,32 +905,69 @@ func getTicketIds(tickets []*pb.Ticket) []string {
 // Calls statestore to add all of the tickets returned by the evaluator to the
 // pendingRelease list.  If it partially fails for whatever reason (not all tickets will
 // necessarily be in the same call), only the matches which can be safely
-// returned to the Synchronize calls are.
-func (s *synchronizerService) addMatchesToPendingRelease(ctx context.Context, m *sync.Map, cancel contextcause.CancelErrFunc, m5c <-chan []string, m6c chan<- string) {
+// returned to the Synchronize calls are.  Accepted dry run matches are returned
+// without touching the pendingRelease list.  Matches with tickets claimed by
+// a concurrent cycle are dropped.
+func (s *synchronizerService) addMatchesToPendingRelease(ctx context.Context, cy *cycle, timeline *cycleTimeline, m *sync.Map, cancel contextcause.CancelErrFunc, m5c <-chan []string, dryRunM5c <-chan []string, m6c chan<- string) {
 	totalMatches := 0
 	successfulMatches := 0
 	var lastErr error
-	for mIDs := range m5c {
-		ids := []string{}
+	for m5c != nil || dryRunM5c != nil {
+		var mIDs []string
+		select {
+		case dryRunIDs, ok := <-dryRunM5c:
+			if !ok {
+				dryRunM5c = nil
+				continue
+			}
+			for _, mID := range dryRunIDs {
+				m6c <- mID
+			}
+			continue
+		case ids, ok := <-m5c:
+			if !ok {
+				m5c = nil
+				continue
+			}
+			mIDs = ids
+		}
+
+		// Tickets are grouped by the pending release timeout of their profile.
+		idsByTimeout := map[time.Duration][]string{}
+		mIDsByTimeout := map[time.Duration][]string{}
+		returned := make([]string, 0, len(mIDs))
 		for _, mID := range mIDs {
-			tids, ok := m.Load(mID)
+			v, ok := m.Load(mID)
 			if ok {
-				ids = append(ids, tids.([]string)...)
+				pt := v.(pendingTickets)
+				if !s.claims.reserve(cy, pt.ids) {
+					logger.Warningf("dropping MatchId %s of partition %s, its tickets were matched by a concurrent cycle", mID, cy.partition.name)
+					continue
+				}
+				idsByTimeout[pt.timeout] = append(idsByTimeout[pt.timeout], pt.ids...)
+				mIDsByTimeout[pt.timeout] = append(mIDsByTimeout[pt.timeout], mID)
 			} else {
 				logger.Errorf("failed to get MatchId %s with its corresponding tickets from the cache", mID)
 			}
+			returned = append(returned, mID)
 		}
 
-		err := s.store.AddTicketsToPendingRelease(ctx, ids)
-
 		totalMatches += len(mIDs)
-		if err == nil {
-			successfulMatches += len(mIDs)
-		} else {
-			lastErr = err
+		for timeout, ids := range idsByTimeout {
+			st := time.Now()
+			err := s.store.AddTicketsToPendingRelease(ctx, ids, timeout)
+			timeline.PendingReleaseLatency += time.Since(st)
+			if err == nil {
+				s.claims.confirm(ids)
+				successfulMatches += len(mIDsByTimeout[timeout])
+			} else {
+				s.claims.release(ids)
+				lastErr = err
+			}
 		}
 
-		for _, mID := range mIDs {
+		timeline.Accepted += len(returned)
+		for _, mID := range returned {
 			m6c <- mID
 		}
 	}
@@ -504,6 +1002,23 @@ func (s *synchronizerService) registrationInterval() time.Duration {
 	return s.cfg.GetDuration(name)
 }
 
+// cycleWindows returns the registration and proposal collection windows for
+// the next cycle.
+func (s *synchronizerService) cycleWindows(p *partition) (time.Duration, time.Duration) {
+	if p.windows != nil {
+		return p.windows.windows()
+	}
+	return s.registrationInterval(), s.proposalCollectionInterval()
+}
+
+func (s *synchronizerService) windowBound(name string, defaultBound time.Duration) time.Duration {
+	if !s.cfg.IsSet(name) {
+		return defaultBound
+	}
+
+	return s.cfg.GetDuration(name)
+}
+
 func (s *synchronizerService) proposalCollectionInterval() time.Duration {
 	const (
 		name            = "proposalCollectionInterval"
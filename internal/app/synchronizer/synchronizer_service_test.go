@@ -0,0 +1,223 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// releasedEvaluator accepts every proposal once the channel for its match id
+// is closed.
+type releasedEvaluator map[string]chan struct{}
+
+func (e releasedEvaluator) evaluate(ctx context.Context, in <-chan []*pb.Match, out chan<- string) error {
+	var ids []string
+	for ms := range in {
+		for _, m := range ms {
+			ids = append(ids, m.GetMatchId())
+		}
+	}
+	for _, id := range ids {
+		<-e[id]
+		out <- id
+	}
+	return nil
+}
+
+// failingEvaluator accepts the first proposal, then fails.  When block is set,
+// it waits for the call to be canceled before failing.
+type failingEvaluator struct {
+	block bool
+}
+
+func (e failingEvaluator) evaluate(ctx context.Context, in <-chan []*pb.Match, out chan<- string) error {
+	ms := <-in
+	out <- ms[0].GetMatchId()
+	if e.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return errors.New("evaluator failed")
+}
+
+func TestEvaluatorFallback(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		fallback string
+		timeout  time.Duration
+		eval     evaluator
+		want     []string
+	}{
+		{
+			name:     "reject",
+			fallback: evaluatorFallbackReject,
+			eval:     failingEvaluator{},
+			want:     []string{"1"},
+		},
+		{
+			name:     "defaulteval",
+			fallback: evaluatorFallbackDefault,
+			eval:     failingEvaluator{},
+			want:     []string{"1", "3"},
+		},
+		{
+			name:     "timeout",
+			fallback: evaluatorFallbackDefault,
+			timeout:  10 * time.Millisecond,
+			eval:     failingEvaluator{block: true},
+			want:     []string{"1", "3"},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := viper.New()
+			cfg.Set("registrationInterval", 10*time.Millisecond)
+			cfg.Set("proposalCollectionInterval", 5*time.Second)
+			cfg.Set("evaluatorFallback", tt.fallback)
+			if tt.timeout > 0 {
+				cfg.Set("evaluatorTimeout", tt.timeout)
+			}
+			store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+			defer closer()
+
+			s := newSynchronizerService(cfg, tt.eval, store)
+			r := s.register(context.Background(), s.partition(""))
+			// The second proposal collides with the first, which the evaluator
+			// accepts before failing.
+			for _, m := range []*pb.Match{
+				{MatchId: "1", Tickets: []*pb.Ticket{{Id: "a"}}},
+				{MatchId: "2", Tickets: []*pb.Ticket{{Id: "a"}}},
+				{MatchId: "3", Tickets: []*pb.Ticket{{Id: "b"}}},
+			} {
+				r.m1c.send(mAndM7c{m: m, m7c: r.m7c})
+			}
+			r.allM1cSent.Done()
+
+			var ids []string
+			for id := range r.m7c {
+				ids = append(ids, id)
+			}
+			require.ElementsMatch(t, tt.want, ids)
+		})
+	}
+}
+
+func TestEvaluatorFallbackDefaultEvaluatorMode(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("registrationInterval", 10*time.Millisecond)
+	cfg.Set("proposalCollectionInterval", 5*time.Second)
+	cfg.Set("evaluatorFallback", evaluatorFallbackDefault)
+	cfg.Set("defaultEvaluator.mode", "maxTickets")
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+
+	criteria, err := ptypes.MarshalAny(&pb.DefaultEvaluationCriteria{Score: 10})
+	require.NoError(t, err)
+
+	s := newSynchronizerService(cfg, failingEvaluator{}, store)
+	r := s.register(context.Background(), s.partition(""))
+	// Evaluating greedily would accept the highest scoring third proposal,
+	// instead of the fourth and fifth with more tickets between them.
+	for _, m := range []*pb.Match{
+		{MatchId: "1", Tickets: []*pb.Ticket{{Id: "a"}}},
+		{MatchId: "3", Tickets: []*pb.Ticket{{Id: "b"}, {Id: "c"}}, Extensions: map[string]*any.Any{"evaluation_input": criteria}},
+		{MatchId: "4", Tickets: []*pb.Ticket{{Id: "b"}, {Id: "d"}}},
+		{MatchId: "5", Tickets: []*pb.Ticket{{Id: "c"}, {Id: "e"}}},
+	} {
+		r.m1c.send(mAndM7c{m: m, m7c: r.m7c})
+	}
+	r.allM1cSent.Done()
+
+	var ids []string
+	for id := range r.m7c {
+		ids = append(ids, id)
+	}
+	require.ElementsMatch(t, []string{"1", "4", "5"}, ids)
+}
+
+func TestPipelinedCycles(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("pipelinedCycles", true)
+	cfg.Set("registrationInterval", 10*time.Millisecond)
+	cfg.Set("proposalCollectionInterval", 5*time.Second)
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+
+	eval := releasedEvaluator{"1": make(chan struct{}), "2": make(chan struct{})}
+	s := newSynchronizerService(cfg, eval, store)
+	p := s.partition("")
+	ctx := context.Background()
+
+	propose := func(r *registration, id string) {
+		r.m1c.send(mAndM7c{
+			m:   &pb.Match{MatchId: id, Tickets: []*pb.Ticket{{Id: "ticket"}}},
+			m7c: r.m7c,
+		})
+		r.allM1cSent.Done()
+	}
+	collect := func(r *registration) []string {
+		var ids []string
+		for id := range r.m7c {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+
+	r1 := s.register(ctx, p)
+	propose(r1, "1")
+	<-r1.m1c.closed
+
+	// The next cycle starts while the first one is still evaluating.
+	registered := make(chan *registration)
+	go func() {
+		registered <- s.register(ctx, p)
+	}()
+	var r2 *registration
+	select {
+	case r2 = <-registered:
+	case <-time.After(time.Second):
+		require.FailNow(t, "second cycle did not start while the first was evaluating")
+	}
+	propose(r2, "2")
+
+	close(eval["1"])
+	require.Equal(t, []string{"1"}, collect(r1))
+
+	// The second cycle's match has the ticket the first cycle just matched.
+	close(eval["2"])
+	require.Empty(t, collect(r2))
+
+	// Both cycles are in the history, the most recent first.
+	require.Eventually(t, func() bool {
+		return len(s.history.recent()) == 2
+	}, time.Second, time.Millisecond)
+	timelines := s.history.recent()
+	require.Equal(t, 1, timelines[0].Registrations)
+	require.Equal(t, 0, timelines[0].Accepted)
+	require.Equal(t, 1, timelines[0].Rejected)
+	require.Equal(t, 1, timelines[1].Registrations)
+	require.Equal(t, 1, timelines[1].Accepted)
+	require.Equal(t, 0, timelines[1].Rejected)
+}
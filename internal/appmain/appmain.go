@@ -26,8 +26,11 @@ import (
 	"go.opencensus.io/stats/view"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"open-match.dev/open-match/internal/auth"
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/logging"
+	"open-match.dev/open-match/internal/ratelimit"
 	"open-match.dev/open-match/internal/rpc"
 	"open-match.dev/open-match/internal/telemetry"
 )
@@ -115,6 +118,12 @@ func (b *Bindings) AddHandleFunc(handlerFunc rpc.GrpcHandler, grpcProxyHandler r
 	b.sp.AddHandleFunc(handlerFunc, grpcProxyHandler)
 }
 
+// AddInterceptors adds gRPC interceptors which run on every call to the
+// server which is starting.  Either may be nil.
+func (b *Bindings) AddInterceptors(unary grpc.UnaryServerInterceptor, stream grpc.StreamServerInterceptor) {
+	b.sp.AddInterceptors(unary, stream)
+}
+
 // TelemetryHandle adds a handler to the mux for serving debug info and metrics.
 func (b *Bindings) TelemetryHandle(pattern string, handler http.Handler) {
 	b.sp.ServeMux.Handle(pattern, handler)
@@ -180,6 +189,27 @@ func NewApplication(serviceName string, bindService Bind, getCfg func() (config.
 		return nil, err
 	}
 
+	authenticator, err := auth.New(cfg, serviceName)
+	if err != nil {
+		surpressedErr := a.Stop() // Don't care about additional errors stopping.
+		_ = surpressedErr
+		return nil, err
+	}
+	if authenticator != nil {
+		b.AddInterceptors(authenticator.UnaryServerInterceptor(), authenticator.StreamServerInterceptor())
+	}
+
+	limiter, err := ratelimit.New(cfg)
+	if err != nil {
+		surpressedErr := a.Stop() // Don't care about additional errors stopping.
+		_ = surpressedErr
+		return nil, err
+	}
+	if limiter != nil {
+		b.AddCloser(limiter.Close)
+		b.AddInterceptors(limiter.UnaryServerInterceptor(), limiter.StreamServerInterceptor())
+	}
+
 	err = bindService(p, b)
 	if err != nil {
 		surpressedErr := a.Stop() // Don't care about additional errors stopping.
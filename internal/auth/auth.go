@@ -0,0 +1,217 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth authenticates the callers of gRPC services with JWT bearer
+// tokens or client certificates, and checks their roles, as configured under
+// auth.
+package auth
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"strings"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/rpc"
+)
+
+const defaultRolesClaim = "roles"
+
+// Principal is an authenticated caller.
+type Principal struct {
+	// ID identifies the caller: the subject of its token, or the name of its
+	// client certificate.
+	ID    string
+	Roles []string
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx with the authenticated caller.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the authenticated caller of the gRPC call, if the
+// service requires authentication.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// Authenticator rejects the calls to a service from callers which are not
+// authenticated with UNAUTHENTICATED, and the ones of callers without any of
+// the roles of the service with PERMISSION_DENIED.
+type Authenticator struct {
+	keys       map[string]crypto.PublicKey
+	issuer     string
+	audience   string
+	rolesClaim string
+
+	// tlsClients are the callers authenticated by client certificate, by subject.
+	tlsClients map[string]*Principal
+
+	roles []string
+}
+
+// New creates the Authenticator of the service, or returns nil if the service
+// is not listed under auth.services.
+// Callers present either a JWT in the authorization metadata as a bearer
+// token, signed by a key of the JWKS file auth.jwt.jwksFile, or a client
+// certificate with the subject auth.tlsClient.<name>.subject of one of the
+// names listed under auth.tlsClients.  Tokens must expire, and their issuer
+// and audience must match auth.jwt.issuer and auth.jwt.audience if set.
+// Token callers are identified by their subject, with the roles of the
+// auth.jwt.rolesClaim claim, and certificate callers by their name, with the
+// roles auth.tlsClient.<name>.roles.  If auth.roles.<service> is set, callers must
+// have one of its roles.
+func New(cfg config.View, serviceName string) (*Authenticator, error) {
+	enabled := false
+	for _, s := range cfg.GetStringSlice("auth.services") {
+		if s == serviceName {
+			enabled = true
+		}
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	a := &Authenticator{
+		issuer:     cfg.GetString("auth.jwt.issuer"),
+		audience:   cfg.GetString("auth.jwt.audience"),
+		rolesClaim: cfg.GetString("auth.jwt.rolesClaim"),
+		tlsClients: map[string]*Principal{},
+		roles:      cfg.GetStringSlice("auth.roles." + serviceName),
+	}
+	if a.rolesClaim == "" {
+		a.rolesClaim = defaultRolesClaim
+	}
+
+	if path := cfg.GetString("auth.jwt.jwksFile"); path != "" {
+		keys, err := readJWKS(path)
+		if err != nil {
+			return nil, err
+		}
+		a.keys = keys
+	}
+
+	for _, name := range cfg.GetStringSlice("auth.tlsClients") {
+		prefix := "auth.tlsClient." + name
+		subject := cfg.GetString(prefix + ".subject")
+		if subject == "" {
+			return nil, fmt.Errorf("%s.subject is required", prefix)
+		}
+		a.tlsClients[subject] = &Principal{ID: name, Roles: cfg.GetStringSlice(prefix + ".roles")}
+	}
+
+	if a.keys == nil && len(a.tlsClients) == 0 {
+		return nil, fmt.Errorf("auth.jwt.jwksFile or auth.tlsClients is required to authenticate the callers of %s", serviceName)
+	}
+	return a, nil
+}
+
+// UnaryServerInterceptor returns an interceptor which authenticates unary calls.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		p, err := a.authenticate(ctx, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		return handler(NewContext(ctx, p), req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor which authenticates streaming calls.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		p, err := a.authenticate(stream.Context(), time.Now())
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = NewContext(stream.Context(), p)
+		return handler(srv, wrapped)
+	}
+}
+
+// authenticate returns the caller of the call, preferring its token over its client certificate.
+func (a *Authenticator) authenticate(ctx context.Context, now time.Time) (*Principal, error) {
+	var p *Principal
+	if token := bearerToken(ctx); token != "" {
+		if a.keys == nil {
+			return nil, status.Error(codes.Unauthenticated, "bearer tokens are not accepted")
+		}
+		c, err := verifyJWT(token, a.keys, now)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %v", err)
+		}
+		if a.issuer != "" && c.Issuer != a.issuer {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: unexpected issuer %q", c.Issuer)
+		}
+		if a.audience != "" && !c.hasAudience(a.audience) {
+			return nil, status.Error(codes.Unauthenticated, "invalid bearer token: unexpected audience")
+		}
+		if c.Subject == "" {
+			return nil, status.Error(codes.Unauthenticated, "invalid bearer token: the sub claim is required")
+		}
+		p = &Principal{ID: c.Subject, Roles: c.roles(a.rolesClaim)}
+	} else if subject := rpc.PeerTLSSubject(ctx); subject != "" {
+		var ok bool
+		if p, ok = a.tlsClients[subject]; !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "unknown client certificate subject %q", subject)
+		}
+	} else {
+		return nil, status.Error(codes.Unauthenticated, "a bearer token or client certificate is required")
+	}
+
+	if !a.hasRole(p) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s does not have any of the roles %v", p.ID, a.roles)
+	}
+	return p, nil
+}
+
+func (a *Authenticator) hasRole(p *Principal) bool {
+	if len(a.roles) == 0 {
+		return true
+	}
+	for _, want := range a.roles {
+		for _, r := range p.Roles {
+			if r == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bearerToken returns the bearer token of the authorization metadata of the call.
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md.Get("authorization") {
+		if len(v) > len("bearer ") && strings.EqualFold(v[:len("bearer ")], "bearer ") {
+			return strings.TrimSpace(v[len("bearer "):])
+		}
+	}
+	return ""
+}
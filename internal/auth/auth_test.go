@@ -0,0 +1,269 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testKeys struct {
+	rsa   *rsa.PrivateKey
+	ec    *ecdsa.PrivateKey
+	ec384 *ecdsa.PrivateKey
+	jwks  []byte
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ec384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	enc := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "n": enc(rsaKey.N), "e": enc(big.NewInt(int64(rsaKey.E)))},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": enc(ecKey.X), "y": enc(ecKey.Y)},
+			{"kty": "EC", "kid": "ec384", "crv": "P-384", "x": enc(ec384Key.X), "y": enc(ec384Key.Y)},
+		},
+	})
+	require.NoError(t, err)
+	return &testKeys{rsa: rsaKey, ec: ecKey, ec384: ec384Key, jwks: jwks}
+}
+
+// sign returns a JWT of the claims, signed by the RSA key with RS256 or the EC key with ES256.
+func (k *testKeys) sign(t *testing.T, kid string, claims map[string]interface{}) string {
+	return k.signWithAlg(t, kid, map[string]string{"rsa": "RS256", "ec": "ES256"}[kid], claims)
+}
+
+// signWithAlg returns a JWT of the claims, signed by the key kid with a SHA-256 digest, whatever the alg
+// of its header.
+func (k *testKeys) signWithAlg(t *testing.T, kid, alg string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var sig []byte
+	if kid == "rsa" {
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k.rsa, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	} else {
+		key := k.ec
+		if kid == "ec384" {
+			key = k.ec384
+		}
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		require.NoError(t, err)
+		size := (key.Curve.Params().BitSize + 7) / 8
+		sig = make([]byte, 2*size)
+		rb, sb := r.Bytes(), s.Bytes()
+		copy(sig[size-len(rb):size], rb)
+		copy(sig[2*size-len(sb):], sb)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func newTestConfig(t *testing.T, keys *testKeys) *viper.Viper {
+	dir, err := ioutil.TempDir("", "auth")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "jwks.json")
+	require.NoError(t, ioutil.WriteFile(path, keys.jwks, 0600))
+
+	cfg := viper.New()
+	cfg.Set("auth.services", []string{"frontend", "backend"})
+	cfg.Set("auth.jwt.jwksFile", path)
+	cfg.Set("auth.jwt.issuer", "https://issuer.example.com")
+	cfg.Set("auth.jwt.audience", "open-match")
+	cfg.Set("auth.tlsClients", []string{"lobby"})
+	cfg.Set("auth.tlsClient.lobby.subject", "CN=lobby,O=Example")
+	cfg.Set("auth.tlsClient.lobby.roles", []string{"frontend"})
+	cfg.Set("auth.roles.backend", []string{"director"})
+	return cfg
+}
+
+func TestNew(t *testing.T) {
+	keys := newTestKeys(t)
+	cfg := newTestConfig(t, keys)
+
+	a, err := New(cfg, "query")
+	require.NoError(t, err)
+	require.Nil(t, a)
+
+	a, err = New(cfg, "frontend")
+	require.NoError(t, err)
+	require.Len(t, a.keys, 3)
+	require.Equal(t, defaultRolesClaim, a.rolesClaim)
+	require.Equal(t, &Principal{ID: "lobby", Roles: []string{"frontend"}}, a.tlsClients["CN=lobby,O=Example"])
+	require.Empty(t, a.roles)
+
+	a, err = New(cfg, "backend")
+	require.NoError(t, err)
+	require.Equal(t, []string{"director"}, a.roles)
+
+	cfg.Set("auth.jwt.jwksFile", "")
+	cfg.Set("auth.tlsClients", []string{})
+	_, err = New(cfg, "frontend")
+	require.EqualError(t, err, "auth.jwt.jwksFile or auth.tlsClients is required to authenticate the callers of frontend")
+}
+
+func TestAuthenticate(t *testing.T) {
+	keys := newTestKeys(t)
+	cfg := newTestConfig(t, keys)
+	frontend, err := New(cfg, "frontend")
+	require.NoError(t, err)
+	backend, err := New(cfg, "backend")
+	require.NoError(t, err)
+
+	now := time.Now()
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"sub":   "player-1",
+			"iss":   "https://issuer.example.com",
+			"aud":   []string{"other", "open-match"},
+			"exp":   now.Add(time.Hour).Unix(),
+			"roles": []string{"player"},
+		}
+	}
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	for _, kid := range []string{"rsa", "ec"} {
+		p, err := frontend.authenticate(withToken(keys.sign(t, kid, validClaims())), now)
+		require.NoError(t, err)
+		require.Equal(t, &Principal{ID: "player-1", Roles: []string{"player"}}, p)
+	}
+
+	tests := []struct {
+		description string
+		modify      func(claims map[string]interface{})
+		wantMessage string
+	}{
+		{
+			description: "expired",
+			modify:      func(c map[string]interface{}) { c["exp"] = now.Add(-time.Minute).Unix() },
+			wantMessage: "invalid bearer token: token is expired",
+		},
+		{
+			description: "no expiry",
+			modify:      func(c map[string]interface{}) { delete(c, "exp") },
+			wantMessage: "invalid bearer token: the exp claim is required",
+		},
+		{
+			description: "not valid yet",
+			modify:      func(c map[string]interface{}) { c["nbf"] = now.Add(time.Minute).Unix() },
+			wantMessage: "invalid bearer token: token is not valid yet",
+		},
+		{
+			description: "wrong issuer",
+			modify:      func(c map[string]interface{}) { c["iss"] = "https://evil.example.com" },
+			wantMessage: `invalid bearer token: unexpected issuer "https://evil.example.com"`,
+		},
+		{
+			description: "wrong audience",
+			modify:      func(c map[string]interface{}) { c["aud"] = "other" },
+			wantMessage: "invalid bearer token: unexpected audience",
+		},
+		{
+			description: "no subject",
+			modify:      func(c map[string]interface{}) { delete(c, "sub") },
+			wantMessage: "invalid bearer token: the sub claim is required",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.description, func(t *testing.T) {
+			claims := validClaims()
+			test.modify(claims)
+			_, err := frontend.authenticate(withToken(keys.sign(t, "rsa", claims)), now)
+			require.Equal(t, codes.Unauthenticated, status.Code(err))
+			require.Equal(t, test.wantMessage, status.Convert(err).Message())
+		})
+	}
+
+	// A token signed by another key is rejected.
+	other := newTestKeys(t)
+	_, err = frontend.authenticate(withToken(other.sign(t, "rsa", validClaims())), now)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, "invalid bearer token: invalid token signature", status.Convert(err).Message())
+
+	// The curve of EC keys must match the algorithm.
+	for kid, alg := range map[string]string{"ec384": "ES256", "ec": "ES384"} {
+		_, err = frontend.authenticate(withToken(keys.signWithAlg(t, kid, alg, validClaims())), now)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		require.Equal(t, "invalid bearer token: signing algorithm "+alg+" does not match the EC key", status.Convert(err).Message())
+	}
+
+	_, err = frontend.authenticate(context.Background(), now)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// A frontend credential cannot call the backend.
+	_, err = backend.authenticate(withToken(keys.sign(t, "ec", validClaims())), now)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	claims := validClaims()
+	claims["roles"] = "director admin"
+	p, err := backend.authenticate(withToken(keys.sign(t, "ec", claims)), now)
+	require.NoError(t, err)
+	require.Equal(t, []string{"director", "admin"}, p.Roles)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	keys := newTestKeys(t)
+	frontend, err := New(newTestConfig(t, keys), "frontend")
+	require.NoError(t, err)
+	token := keys.sign(t, "rsa", map[string]interface{}{
+		"sub": "player-1",
+		"iss": "https://issuer.example.com",
+		"aud": "open-match",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	var got *Principal
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got, _ = FromContext(ctx)
+		return nil, nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+token))
+	_, err = frontend.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "player-1", got.ID)
+}
@@ -0,0 +1,337 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit limits the rate of gRPC calls per client and caps the
+// number of indexed Tickets, as configured under rateLimits.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/auth"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/internal/statestore"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+var (
+	logger = logrus.WithFields(logrus.Fields{
+		"app":       "openmatch",
+		"component": "ratelimit",
+	})
+)
+
+const (
+	sourceMetadata    = "metadata"
+	sourceTLSSubject  = "tlsSubject"
+	sourceSearchField = "searchField"
+	sourcePrincipal   = "principal"
+
+	// sweepInterval is how often the buckets of idle clients are dropped.
+	sweepInterval = time.Minute
+)
+
+// ticketCreatingMethods are the methods which are rejected if the Tickets
+// they create would exceed rateLimits.maxIndexedTickets.  Their calls take a
+// token per created Ticket from their rate limits.
+var ticketCreatingMethods = map[string]bool{
+	"/openmatch.FrontendService/CreateTicket":       true,
+	"/openmatch.FrontendService/BatchCreateTickets": true,
+	"/openmatch.FrontendService/CreateParty":        true,
+}
+
+// Limiter rejects the calls of a client to a method above the configured rate
+// with RESOURCE_EXHAUSTED.  Limits are kept in memory, so each replica of a
+// service enforces them separately.
+type Limiter struct {
+	sources     []string
+	metadataKey string
+	searchField string
+	methods     map[string]*methodLimit
+
+	maxIndexedTickets int
+	store             statestore.Service
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+// methodLimit is a token bucket limit: calls are allowed at ratePerSecond,
+// with bursts of up to burst calls.  Calls which create several Tickets count
+// as a call per Ticket, so batches larger than burst are always rejected.
+type methodLimit struct {
+	ratePerSecond float64
+	burst         float64
+}
+
+type bucketKey struct {
+	method string
+	client string
+}
+
+type bucket struct {
+	limit  *methodLimit
+	tokens float64
+	last   time.Time
+}
+
+// New creates a Limiter for the methods listed under rateLimits.methods and
+// the rateLimits.maxIndexedTickets cap, or returns nil if neither is set.
+// Each method is configured under rateLimits.<name>, where the name is the
+// method name without its service, with its ratePerSecond and burst.
+// Clients are identified by the first of the rateLimits.clientIdentity.sources
+// which is present on the call: "metadata" for the gRPC metadata
+// rateLimits.clientIdentity.metadataKey, "tlsSubject" for the subject of the
+// client certificate, "searchField" for the string arg
+// rateLimits.clientIdentity.searchField of the created Ticket or Backfill, or
+// "principal" for the caller authenticated as configured under auth.
+// Calls without any of them are identified by the peer address.
+func New(cfg config.View) (*Limiter, error) {
+	l := &Limiter{
+		sources:           cfg.GetStringSlice("rateLimits.clientIdentity.sources"),
+		metadataKey:       strings.ToLower(cfg.GetString("rateLimits.clientIdentity.metadataKey")),
+		searchField:       cfg.GetString("rateLimits.clientIdentity.searchField"),
+		methods:           map[string]*methodLimit{},
+		maxIndexedTickets: cfg.GetInt("rateLimits.maxIndexedTickets"),
+		buckets:           map[bucketKey]*bucket{},
+		lastSweep:         time.Now(),
+	}
+
+	for _, source := range l.sources {
+		switch source {
+		case sourceMetadata:
+			if l.metadataKey == "" {
+				return nil, fmt.Errorf("rateLimits.clientIdentity.metadataKey is required for the %s source", source)
+			}
+		case sourceSearchField:
+			if l.searchField == "" {
+				return nil, fmt.Errorf("rateLimits.clientIdentity.searchField is required for the %s source", source)
+			}
+		case sourceTLSSubject, sourcePrincipal:
+		default:
+			return nil, fmt.Errorf("unknown client identity source %s", source)
+		}
+	}
+
+	for _, name := range cfg.GetStringSlice("rateLimits.methods") {
+		prefix := "rateLimits." + name
+		limit := &methodLimit{
+			ratePerSecond: cfg.GetFloat64(prefix + ".ratePerSecond"),
+			burst:         cfg.GetFloat64(prefix + ".burst"),
+		}
+		if limit.ratePerSecond <= 0 {
+			return nil, fmt.Errorf("%s.ratePerSecond must be positive", prefix)
+		}
+		if limit.burst < 1 {
+			limit.burst = math.Max(1, limit.ratePerSecond)
+		}
+		l.methods[name] = limit
+	}
+
+	if len(l.methods) == 0 && l.maxIndexedTickets <= 0 {
+		return nil, nil
+	}
+	if l.maxIndexedTickets > 0 {
+		l.store = statestore.New(cfg)
+	}
+	return l, nil
+}
+
+// Close releases the state storage connections of the Limiter.
+func (l *Limiter) Close() {
+	if l.store != nil {
+		if err := l.store.Close(); err != nil {
+			logger.WithError(err).Warning("failed to close the rate limiter state storage")
+		}
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor which limits unary calls.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.admit(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor which limits streaming
+// calls.  Streams are admitted when their first request is received.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &limitedStream{
+			WrappedServerStream: grpc_middleware.WrapServerStream(stream),
+			limiter:             l,
+			method:              info.FullMethod,
+		})
+	}
+}
+
+type limitedStream struct {
+	*grpc_middleware.WrappedServerStream
+	limiter  *Limiter
+	method   string
+	admitted bool
+}
+
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.admitted {
+		if err := s.limiter.admit(s.Context(), s.method, m); err != nil {
+			return err
+		}
+		s.admitted = true
+	}
+	return nil
+}
+
+// admit returns RESOURCE_EXHAUSTED if the call is over the rate limit of its
+// client, or creates Tickets while the cap of indexed Tickets is reached.
+func (l *Limiter) admit(ctx context.Context, fullMethod string, req interface{}) error {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	cost := 1
+	if ticketCreatingMethods[fullMethod] {
+		cost = ticketCount(req)
+	}
+
+	if limit, ok := l.methods[method]; ok {
+		client := l.clientIdentity(ctx, req)
+		if !l.allow(bucketKey{method: method, client: client}, limit, cost, time.Now()) {
+			return status.Errorf(codes.ResourceExhausted, "rate limit of %v calls per second to %s exceeded by client %s", limit.ratePerSecond, method, client)
+		}
+	}
+
+	if l.maxIndexedTickets > 0 && ticketCreatingMethods[fullMethod] {
+		count, err := l.store.CountIndexedTickets(ctx)
+		if err != nil {
+			return err
+		}
+		if count+cost > l.maxIndexedTickets {
+			return status.Errorf(codes.ResourceExhausted, "creating %d tickets would exceed the maximum of %d indexed tickets", cost, l.maxIndexedTickets)
+		}
+	}
+	return nil
+}
+
+// ticketCount returns the number of Tickets created by the request, at least one.
+func ticketCount(req interface{}) int {
+	if r, ok := req.(interface{ GetTickets() []*pb.Ticket }); ok && len(r.GetTickets()) > 1 {
+		return len(r.GetTickets())
+	}
+	return 1
+}
+
+// allow takes cost tokens from the bucket of the key, and returns whether there were enough.
+func (l *Limiter) allow(key bucketKey, limit *methodLimit, cost int, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limit: limit, tokens: limit.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.refill(now)
+	if b.tokens < float64(cost) {
+		return false
+	}
+	b.tokens -= float64(cost)
+	return true
+}
+
+// sweep drops the buckets which are full again, as they are the same as new ones.
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= b.limit.burst {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+func (b *bucket) refill(now time.Time) {
+	b.tokens = math.Min(b.limit.burst, b.tokens+now.Sub(b.last).Seconds()*b.limit.ratePerSecond)
+	b.last = now
+}
+
+// clientIdentity returns the identity of the client making the call.
+func (l *Limiter) clientIdentity(ctx context.Context, req interface{}) string {
+	for _, source := range l.sources {
+		var id string
+		switch source {
+		case sourceMetadata:
+			if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(l.metadataKey)) > 0 {
+				id = md.Get(l.metadataKey)[0]
+			}
+		case sourceTLSSubject:
+			id = rpc.PeerTLSSubject(ctx)
+		case sourceSearchField:
+			id = searchFieldsOf(req).GetStringArgs()[l.searchField]
+		case sourcePrincipal:
+			if p, ok := auth.FromContext(ctx); ok {
+				id = p.ID
+			}
+		}
+		if id != "" {
+			return source + ":" + id
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return "peer:" + host
+		}
+		return "peer:" + p.Addr.String()
+	}
+	return ""
+}
+
+// searchFieldsOf returns the search fields of the Ticket or Backfill created
+// or updated by the request, or of its first Ticket.
+func searchFieldsOf(req interface{}) *pb.SearchFields {
+	switch r := req.(type) {
+	case interface{ GetTicket() *pb.Ticket }:
+		return r.GetTicket().GetSearchFields()
+	case interface{ GetBackfill() *pb.Backfill }:
+		return r.GetBackfill().GetSearchFields()
+	case interface{ GetTickets() []*pb.Ticket }:
+		if tickets := r.GetTickets(); len(tickets) > 0 {
+			return tickets[0].GetSearchFields()
+		}
+	}
+	return nil
+}
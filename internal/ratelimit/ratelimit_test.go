@@ -0,0 +1,180 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	createTicket       = "/openmatch.FrontendService/CreateTicket"
+	batchCreateTickets = "/openmatch.FrontendService/BatchCreateTickets"
+)
+
+func newTestConfig() *viper.Viper {
+	cfg := viper.New()
+	cfg.Set("rateLimits.clientIdentity.sources", []string{"metadata", "searchField"})
+	cfg.Set("rateLimits.clientIdentity.metadataKey", "X-Client-Id")
+	cfg.Set("rateLimits.clientIdentity.searchField", "client")
+	cfg.Set("rateLimits.methods", []string{"CreateTicket", "BatchCreateTickets"})
+	cfg.Set("rateLimits.CreateTicket.ratePerSecond", 1)
+	cfg.Set("rateLimits.CreateTicket.burst", 2)
+	cfg.Set("rateLimits.BatchCreateTickets.ratePerSecond", 1)
+	cfg.Set("rateLimits.BatchCreateTickets.burst", 2)
+	return cfg
+}
+
+func TestNew(t *testing.T) {
+	l, err := New(viper.New())
+	require.NoError(t, err)
+	require.Nil(t, l)
+
+	l, err = New(newTestConfig())
+	require.NoError(t, err)
+	require.Equal(t, "x-client-id", l.metadataKey)
+	require.Equal(t, &methodLimit{ratePerSecond: 1, burst: 2}, l.methods["CreateTicket"])
+
+	cfg := newTestConfig()
+	cfg.Set("rateLimits.clientIdentity.sources", []string{"cookie"})
+	_, err = New(cfg)
+	require.EqualError(t, err, "unknown client identity source cookie")
+
+	cfg = newTestConfig()
+	cfg.Set("rateLimits.clientIdentity.metadataKey", "")
+	_, err = New(cfg)
+	require.EqualError(t, err, "rateLimits.clientIdentity.metadataKey is required for the metadata source")
+
+	cfg = newTestConfig()
+	cfg.Set("rateLimits.CreateTicket.ratePerSecond", 0)
+	_, err = New(cfg)
+	require.EqualError(t, err, "rateLimits.CreateTicket.ratePerSecond must be positive")
+}
+
+func TestAllow(t *testing.T) {
+	l, err := New(newTestConfig())
+	require.NoError(t, err)
+	limit := l.methods["CreateTicket"]
+	a := bucketKey{method: "CreateTicket", client: "a"}
+	b := bucketKey{method: "CreateTicket", client: "b"}
+
+	now := time.Now()
+	require.True(t, l.allow(a, limit, 1, now))
+	require.True(t, l.allow(a, limit, 1, now))
+	require.False(t, l.allow(a, limit, 1, now))
+	// Clients have their own buckets.
+	require.True(t, l.allow(b, limit, 1, now))
+
+	now = now.Add(time.Second)
+	require.True(t, l.allow(a, limit, 1, now))
+	require.False(t, l.allow(a, limit, 1, now))
+
+	// Full buckets are dropped by the next sweep.
+	now = now.Add(sweepInterval)
+	require.True(t, l.allow(a, limit, 1, now))
+	require.Len(t, l.buckets, 1)
+	// Calls creating several tickets take a token per ticket.
+	require.False(t, l.allow(a, limit, 2, now))
+	now = now.Add(time.Second)
+	require.True(t, l.allow(a, limit, 2, now))
+	require.False(t, l.allow(b, limit, 3, now))
+}
+
+func TestClientIdentity(t *testing.T) {
+	l, err := New(newTestConfig())
+	require.NoError(t, err)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234}})
+	require.Equal(t, "peer:10.0.0.1", l.clientIdentity(ctx, &pb.CreateTicketRequest{}))
+
+	req := &pb.CreateTicketRequest{Ticket: &pb.Ticket{SearchFields: &pb.SearchFields{
+		StringArgs: map[string]string{"client": "game-1.2"},
+	}}}
+	require.Equal(t, "searchField:game-1.2", l.clientIdentity(ctx, req))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-client-id", "lobby"))
+	require.Equal(t, "metadata:lobby", l.clientIdentity(ctx, req))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l, err := New(newTestConfig())
+	require.NoError(t, err)
+	interceptor := l.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.Ticket{}, nil
+	}
+	call := func(client, method string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-client-id", client))
+		_, err := interceptor(ctx, &pb.CreateTicketRequest{}, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	require.NoError(t, call("a", createTicket))
+	require.NoError(t, call("a", createTicket))
+	err = call("a", createTicket)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.NoError(t, call("b", createTicket))
+
+	// A batch counts as a call per ticket.
+	batch := &pb.BatchCreateTicketsRequest{Tickets: []*pb.Ticket{{}, {}, {}}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-client-id", "a"))
+	_, err = interceptor(ctx, batch, &grpc.UnaryServerInfo{FullMethod: batchCreateTickets}, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	batch.Tickets = batch.Tickets[:2]
+	_, err = interceptor(ctx, batch, &grpc.UnaryServerInfo{FullMethod: batchCreateTickets}, handler)
+	require.NoError(t, err)
+
+	// Methods without a limit are not limited.
+	for i := 0; i < 5; i++ {
+		require.NoError(t, call("a", "/openmatch.FrontendService/GetTicket"))
+	}
+}
+
+func TestMaxIndexedTickets(t *testing.T) {
+	ctx := utilTesting.NewContext(t)
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	cfg.Set("rateLimits.maxIndexedTickets", 1)
+	l, err := New(cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	require.NoError(t, l.admit(ctx, createTicket, &pb.CreateTicketRequest{}))
+	// A batch may not go past the cap either.
+	err = l.admit(ctx, batchCreateTickets, &pb.BatchCreateTicketsRequest{Tickets: []*pb.Ticket{{}, {}}})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, "creating 2 tickets would exceed the maximum of 1 indexed tickets", status.Convert(err).Message())
+
+	require.NoError(t, store.CreateTicket(ctx, &pb.Ticket{Id: "1"}))
+	require.NoError(t, store.IndexTicket(ctx, &pb.Ticket{Id: "1"}))
+	err = l.admit(ctx, createTicket, &pb.CreateTicketRequest{})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// Other methods are not capped.
+	require.NoError(t, l.admit(ctx, "/openmatch.FrontendService/CreateBackfill", &pb.CreateBackfillRequest{}))
+}
@@ -68,6 +68,8 @@ type ServerParams struct {
 	handlersForGrpc        []GrpcHandler
 	handlersForGrpcProxy   []GrpcProxyHandler
 	handlersForHealthCheck []func(context.Context) error
+	unaryInterceptors      []grpc.UnaryServerInterceptor
+	streamInterceptors     []grpc.StreamServerInterceptor
 
 	grpcListener      net.Listener
 	grpcProxyListener net.Listener
@@ -185,6 +187,16 @@ func (p *ServerParams) AddHealthCheckFunc(handlerFunc func(context.Context) erro
 	}
 }
 
+// AddInterceptors adds interceptors which run on every gRPC call, after the built in ones.
+func (p *ServerParams) AddInterceptors(unary grpc.UnaryServerInterceptor, stream grpc.StreamServerInterceptor) {
+	if unary != nil {
+		p.unaryInterceptors = append(p.unaryInterceptors, unary)
+	}
+	if stream != nil {
+		p.streamInterceptors = append(p.streamInterceptors, stream)
+	}
+}
+
 // invalidate closes all the TCP listeners that would otherwise leak if initialization fails.
 func (p *ServerParams) invalidate() {
 	if err := p.grpcListener.Close(); err != nil {
@@ -288,6 +300,9 @@ func newGRPCServerOptions(params *ServerParams) []grpc.ServerOption {
 		}
 	}
 
+	ui = append(ui, params.unaryInterceptors...)
+	si = append(si, params.streamInterceptors...)
+
 	ui = append(ui, serverUnaryInterceptor)
 	si = append(si, serverStreamInterceptor)
 
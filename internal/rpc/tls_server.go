@@ -79,7 +79,12 @@ func (s *tlsServer) start(params *ServerParams) error {
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	creds := credentials.NewServerTLSFromCert(grpcTLSCertificate)
+	// Client certificates are optional, but verified if given so that their subject identifies the client.
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{*grpcTLSCertificate},
+		ClientCAs:    rootCaCert,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+	})
 	serverOpts := newGRPCServerOptions(params)
 	serverOpts = append(serverOpts, grpc.Creds(creds))
 	s.grpcServer = grpc.NewServer(serverOpts...)
@@ -15,12 +15,15 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
 
 	"crypto/tls"
 	"crypto/x509"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 )
 
 func trustedCertificateFromFileData(publicCertFileData []byte) (*x509.CertPool, error) {
@@ -42,3 +45,17 @@ func certificateFromFileData(publicCertFileData []byte, privateKeyFileData []byt
 	}
 	return &cert, nil
 }
+
+// PeerTLSSubject returns the subject of the verified client certificate of the gRPC call, or "" if the client
+// did not present one.
+func PeerTLSSubject(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return info.State.VerifiedChains[0][0].Subject.String()
+}
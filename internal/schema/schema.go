@@ -0,0 +1,248 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schema validates the SearchFields and Extensions of Tickets and
+// Backfills against the schema configured under searchFieldSchema.
+package schema
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// Schema lists the search fields which Tickets and Backfills may and must
+// have, and the values they may take.
+type Schema struct {
+	// Allowed double args, string args and tags, any are allowed if nil.
+	doubleArgs map[string]bool
+	stringArgs map[string]bool
+	tags       map[string]bool
+
+	required []string
+	ranges   map[string]valueRange
+	enums    map[string]map[string]bool
+
+	maxExtensions     int
+	maxExtensionBytes int
+}
+
+type valueRange struct {
+	min float64
+	max float64
+}
+
+// New creates the Schema configured under searchFieldSchema, or returns nil
+// if there is none.
+//   - doubleArgs, stringArgs and tags list the allowed names, any are allowed if a list is empty.
+//   - required lists the names which must be set as a double arg, string arg or tag.
+//   - ranges.<name>.min and ranges.<name>.max bound the values of the double arg name,
+//     which must be listed in doubleArgs, or New fails.
+//   - enums.<name> lists the allowed values of the string arg name, which must
+//     be listed in stringArgs, or New fails.
+//   - maxExtensions and maxExtensionBytes limit the number and the total
+//     serialized size of the extensions, 0 for no limit.
+func New(cfg config.View) (*Schema, error) {
+	if !cfg.IsSet("searchFieldSchema") {
+		return nil, nil
+	}
+
+	s := &Schema{
+		doubleArgs:        allowList(cfg.GetStringSlice("searchFieldSchema.doubleArgs")),
+		stringArgs:        allowList(cfg.GetStringSlice("searchFieldSchema.stringArgs")),
+		tags:              allowList(cfg.GetStringSlice("searchFieldSchema.tags")),
+		required:          cfg.GetStringSlice("searchFieldSchema.required"),
+		ranges:            map[string]valueRange{},
+		enums:             map[string]map[string]bool{},
+		maxExtensions:     cfg.GetInt("searchFieldSchema.maxExtensions"),
+		maxExtensionBytes: cfg.GetInt("searchFieldSchema.maxExtensionBytes"),
+	}
+
+	// Viper lower cases the keys of maps, so the args with ranges and enums
+	// are looked up by the names of the allowed args instead, after checking
+	// that they name no other args.
+	if err := checkNames(cfg, "searchFieldSchema.ranges", s.doubleArgs, "searchFieldSchema.doubleArgs"); err != nil {
+		return nil, err
+	}
+	if err := checkNames(cfg, "searchFieldSchema.enums", s.stringArgs, "searchFieldSchema.stringArgs"); err != nil {
+		return nil, err
+	}
+
+	for name := range s.doubleArgs {
+		prefix := "searchFieldSchema.ranges." + name
+		if !cfg.IsSet(prefix) {
+			continue
+		}
+		r := valueRange{min: math.Inf(-1), max: math.Inf(1)}
+		if cfg.IsSet(prefix + ".min") {
+			r.min = cfg.GetFloat64(prefix + ".min")
+		}
+		if cfg.IsSet(prefix + ".max") {
+			r.max = cfg.GetFloat64(prefix + ".max")
+		}
+		if r.min > r.max {
+			return nil, fmt.Errorf("%s.min %v is greater than its max %v", prefix, r.min, r.max)
+		}
+		s.ranges[name] = r
+	}
+	for name := range s.stringArgs {
+		values := cfg.GetStringSlice("searchFieldSchema.enums." + name)
+		if len(values) > 0 {
+			s.enums[name] = allowList(values)
+		}
+	}
+	return s, nil
+}
+
+// checkNames returns an error if the config under prefix names an arg which
+// is not in the allowed args listed under listKey.
+func checkNames(cfg config.View, prefix string, allowed map[string]bool, listKey string) error {
+	if !cfg.IsSet(prefix) {
+		return nil
+	}
+	if allowed == nil {
+		return fmt.Errorf("%s requires the args to be listed in %s", prefix, listKey)
+	}
+
+	v, ok := cfg.(interface{ AllKeys() []string })
+	if !ok {
+		return nil
+	}
+	lowerAllowed := make(map[string]bool, len(allowed))
+	for name := range allowed {
+		lowerAllowed[strings.ToLower(name)] = true
+	}
+	keyPrefix := strings.ToLower(prefix) + "."
+	for _, key := range v.AllKeys() {
+		if !strings.HasPrefix(key, keyPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(key, keyPrefix)
+		if i := strings.Index(name, "."); i >= 0 {
+			name = name[:i]
+		}
+		if !lowerAllowed[name] {
+			return fmt.Errorf("%s.%s names an arg which is not listed in %s", prefix, name, listKey)
+		}
+	}
+	return nil
+}
+
+func allowList(names []string) map[string]bool {
+	if len(names) == 0 {
+		return nil
+	}
+	m := make(map[string]bool, len(names))
+	for _, name := range names {
+		m[name] = true
+	}
+	return m
+}
+
+// ValidateTicket returns INVALID_ARGUMENT if the Ticket does not match the
+// Schema.  A nil Schema accepts all Tickets.
+func (s *Schema) ValidateTicket(ticket *pb.Ticket) error {
+	return s.validate(".ticket", ticket.GetSearchFields(), ticket.GetExtensions())
+}
+
+// ValidateBackfill returns INVALID_ARGUMENT if the Backfill does not match
+// the Schema.  A nil Schema accepts all Backfills.
+func (s *Schema) ValidateBackfill(backfill *pb.Backfill) error {
+	return s.validate(".backfill", backfill.GetSearchFields(), backfill.GetExtensions())
+}
+
+func (s *Schema) validate(field string, sf *pb.SearchFields, extensions map[string]*any.Any) error {
+	if s == nil {
+		return nil
+	}
+
+	for _, name := range sortedDoubleArgs(sf) {
+		v := sf.GetDoubleArgs()[name]
+		if s.doubleArgs != nil && !s.doubleArgs[name] {
+			return status.Errorf(codes.InvalidArgument, "%s.search_fields.double_args has unknown arg %q", field, name)
+		}
+		if r, ok := s.ranges[name]; ok && !(v >= r.min && v <= r.max) {
+			return status.Errorf(codes.InvalidArgument, "%s.search_fields.double_args[%q] %v is outside of the range [%v, %v]", field, name, v, r.min, r.max)
+		}
+	}
+
+	for _, name := range sortedStringArgs(sf) {
+		v := sf.GetStringArgs()[name]
+		if s.stringArgs != nil && !s.stringArgs[name] {
+			return status.Errorf(codes.InvalidArgument, "%s.search_fields.string_args has unknown arg %q", field, name)
+		}
+		if values, ok := s.enums[name]; ok && !values[v] {
+			return status.Errorf(codes.InvalidArgument, "%s.search_fields.string_args[%q] %q is not one of the allowed values", field, name, v)
+		}
+	}
+
+	tags := make(map[string]bool, len(sf.GetTags()))
+	for _, tag := range sf.GetTags() {
+		if s.tags != nil && !s.tags[tag] {
+			return status.Errorf(codes.InvalidArgument, "%s.search_fields.tags has unknown tag %q", field, tag)
+		}
+		tags[tag] = true
+	}
+
+	for _, name := range s.required {
+		_, isDouble := sf.GetDoubleArgs()[name]
+		_, isString := sf.GetStringArgs()[name]
+		if !isDouble && !isString && !tags[name] {
+			return status.Errorf(codes.InvalidArgument, "%s.search_fields is missing the required field %q", field, name)
+		}
+	}
+
+	if s.maxExtensions > 0 && len(extensions) > s.maxExtensions {
+		return status.Errorf(codes.InvalidArgument, "%s.extensions has %d extensions, more than the maximum of %d", field, len(extensions), s.maxExtensions)
+	}
+	if s.maxExtensionBytes > 0 {
+		size := 0
+		for _, ext := range extensions {
+			size += proto.Size(ext)
+		}
+		if size > s.maxExtensionBytes {
+			return status.Errorf(codes.InvalidArgument, "%s.extensions are %d bytes, more than the maximum of %d", field, size, s.maxExtensionBytes)
+		}
+	}
+	return nil
+}
+
+// sortedDoubleArgs returns the names of the double args in order, so that
+// the first invalid arg reported is always the same.
+func sortedDoubleArgs(sf *pb.SearchFields) []string {
+	names := make([]string, 0, len(sf.GetDoubleArgs()))
+	for name := range sf.GetDoubleArgs() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedStringArgs returns the names of the string args in order.
+func sortedStringArgs(sf *pb.SearchFields) []string {
+	names := make([]string, 0, len(sf.GetStringArgs()))
+	for name := range sf.GetStringArgs() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
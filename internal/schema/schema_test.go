@@ -0,0 +1,171 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func newTestConfig() *viper.Viper {
+	cfg := viper.New()
+	cfg.Set("searchFieldSchema.doubleArgs", []string{"mmr", "level"})
+	cfg.Set("searchFieldSchema.stringArgs", []string{"region", "mode"})
+	cfg.Set("searchFieldSchema.tags", []string{"beta"})
+	cfg.Set("searchFieldSchema.required", []string{"mmr", "region"})
+	cfg.Set("searchFieldSchema.ranges.mmr.min", 0)
+	cfg.Set("searchFieldSchema.ranges.mmr.max", 5000)
+	cfg.Set("searchFieldSchema.ranges.level.min", 1)
+	cfg.Set("searchFieldSchema.enums.region", []string{"eu", "us"})
+	cfg.Set("searchFieldSchema.maxExtensions", 1)
+	cfg.Set("searchFieldSchema.maxExtensionBytes", 64)
+	return cfg
+}
+
+func TestNew(t *testing.T) {
+	s, err := New(viper.New())
+	require.NoError(t, err)
+	require.Nil(t, s)
+	require.NoError(t, s.ValidateTicket(&pb.Ticket{SearchFields: &pb.SearchFields{Tags: []string{"any"}}}))
+
+	s, err = New(newTestConfig())
+	require.NoError(t, err)
+	require.Equal(t, valueRange{min: 0, max: 5000}, s.ranges["mmr"])
+	require.Equal(t, valueRange{min: 1, max: math.Inf(1)}, s.ranges["level"])
+	require.Equal(t, map[string]bool{"eu": true, "us": true}, s.enums["region"])
+	require.NotContains(t, s.enums, "mode")
+
+	cfg := newTestConfig()
+	cfg.Set("searchFieldSchema.ranges.mmr.min", 6000)
+	_, err = New(cfg)
+	require.EqualError(t, err, "searchFieldSchema.ranges.mmr.min 6000 is greater than its max 5000")
+
+	// Ranges and enums are not silently ignored when their args are not listed.
+	cfg = viper.New()
+	cfg.Set("searchFieldSchema.ranges.mmr.max", 5000)
+	_, err = New(cfg)
+	require.EqualError(t, err, "searchFieldSchema.ranges requires the args to be listed in searchFieldSchema.doubleArgs")
+
+	cfg = newTestConfig()
+	cfg.Set("searchFieldSchema.enums.regoin", []string{"eu"})
+	_, err = New(cfg)
+	require.EqualError(t, err, "searchFieldSchema.enums.regoin names an arg which is not listed in searchFieldSchema.stringArgs")
+}
+
+func TestValidate(t *testing.T) {
+	s, err := New(newTestConfig())
+	require.NoError(t, err)
+
+	small, err := ptypes.MarshalAny(&wrappers.StringValue{Value: "small"})
+	require.NoError(t, err)
+	large, err := ptypes.MarshalAny(&wrappers.StringValue{Value: "a value which is too large to fit"})
+	require.NoError(t, err)
+
+	valid := func() *pb.SearchFields {
+		return &pb.SearchFields{
+			DoubleArgs: map[string]float64{"mmr": 1200, "level": 3},
+			StringArgs: map[string]string{"region": "eu", "mode": "ranked"},
+			Tags:       []string{"beta"},
+		}
+	}
+
+	tests := []struct {
+		description string
+		modify      func(sf *pb.SearchFields)
+		extensions  map[string]*any.Any
+		wantMessage string
+	}{
+		{
+			description: "valid",
+			modify:      func(sf *pb.SearchFields) {},
+			extensions:  map[string]*any.Any{"small": small},
+		},
+		{
+			description: "unknown double arg",
+			modify:      func(sf *pb.SearchFields) { sf.DoubleArgs["nmr"] = 1 },
+			wantMessage: `.ticket.search_fields.double_args has unknown arg "nmr"`,
+		},
+		{
+			description: "double arg out of range",
+			modify:      func(sf *pb.SearchFields) { sf.DoubleArgs["mmr"] = 9000 },
+			wantMessage: `.ticket.search_fields.double_args["mmr"] 9000 is outside of the range [0, 5000]`,
+		},
+		{
+			description: "double arg NaN",
+			modify:      func(sf *pb.SearchFields) { sf.DoubleArgs["level"] = math.NaN() },
+			wantMessage: `.ticket.search_fields.double_args["level"] NaN is outside of the range [1, +Inf]`,
+		},
+		{
+			description: "unknown string arg",
+			modify:      func(sf *pb.SearchFields) { sf.StringArgs["regoin"] = "eu" },
+			wantMessage: `.ticket.search_fields.string_args has unknown arg "regoin"`,
+		},
+		{
+			description: "string arg not in enum",
+			modify:      func(sf *pb.SearchFields) { sf.StringArgs["region"] = "mars" },
+			wantMessage: `.ticket.search_fields.string_args["region"] "mars" is not one of the allowed values`,
+		},
+		{
+			description: "unknown tag",
+			modify:      func(sf *pb.SearchFields) { sf.Tags = append(sf.Tags, "alpha") },
+			wantMessage: `.ticket.search_fields.tags has unknown tag "alpha"`,
+		},
+		{
+			description: "missing required field",
+			modify:      func(sf *pb.SearchFields) { delete(sf.StringArgs, "region") },
+			wantMessage: `.ticket.search_fields is missing the required field "region"`,
+		},
+		{
+			description: "too many extensions",
+			modify:      func(sf *pb.SearchFields) {},
+			extensions:  map[string]*any.Any{"a": small, "b": small},
+			wantMessage: ".ticket.extensions has 2 extensions, more than the maximum of 1",
+		},
+		{
+			description: "extensions too large",
+			modify:      func(sf *pb.SearchFields) {},
+			extensions:  map[string]*any.Any{"large": large},
+			wantMessage: ".ticket.extensions are 86 bytes, more than the maximum of 64",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.description, func(t *testing.T) {
+			sf := valid()
+			test.modify(sf)
+			err := s.ValidateTicket(&pb.Ticket{SearchFields: sf, Extensions: test.extensions})
+			if test.wantMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			require.Equal(t, test.wantMessage, status.Convert(err).Message())
+		})
+	}
+
+	err = s.ValidateBackfill(&pb.Backfill{SearchFields: &pb.SearchFields{}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, `.backfill.search_fields is missing the required field "mmr"`, status.Convert(err).Message())
+}
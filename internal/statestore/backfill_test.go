@@ -728,7 +728,7 @@ func BenchmarkCleanupBackfills(b *testing.B) {
 		_, err = rc.Do("ZADD", "backfill_last_ack_time", 123, bfID)
 		require.NoError(t, err)
 
-		err = service.AddTicketsToPendingRelease(ctx, ticketIDs)
+		err = service.AddTicketsToPendingRelease(ctx, ticketIDs, 0)
 		require.NoError(t, err)
 
 		err = service.IndexBackfill(ctx, bf)
@@ -774,7 +774,7 @@ func TestCleanupBackfills(t *testing.T) {
 	_, err = rc.Do("ZADD", bfLastAck, 123, bfID)
 	require.NoError(t, err)
 
-	err = service.AddTicketsToPendingRelease(ctx, ticketIDs)
+	err = service.AddTicketsToPendingRelease(ctx, ticketIDs, 0)
 	require.NoError(t, err)
 
 	err = service.IndexBackfill(ctx, bf)
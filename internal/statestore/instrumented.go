@@ -16,6 +16,7 @@ package statestore
 
 import (
 	"context"
+	"time"
 
 	"go.opencensus.io/trace"
 	"open-match.dev/open-match/pkg/pb"
@@ -41,30 +42,84 @@ func (is *instrumentedService) CreateTicket(ctx context.Context, ticket *pb.Tick
 	return is.s.CreateTicket(ctx, ticket)
 }
 
+func (is *instrumentedService) CreateTicketIdempotent(ctx context.Context, ticket *pb.Ticket, key string) (*pb.Ticket, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.CreateTicketIdempotent")
+	defer span.End()
+	return is.s.CreateTicketIdempotent(ctx, ticket, key)
+}
+
+func (is *instrumentedService) CreateTickets(ctx context.Context, tickets []*pb.Ticket) ([]error, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.CreateTickets")
+	defer span.End()
+	return is.s.CreateTickets(ctx, tickets)
+}
+
 func (is *instrumentedService) GetTicket(ctx context.Context, id string) (*pb.Ticket, error) {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetTicket")
 	defer span.End()
 	return is.s.GetTicket(ctx, id)
 }
 
+func (is *instrumentedService) GetTicketWithStatus(ctx context.Context, id string) (*pb.Ticket, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetTicketWithStatus")
+	defer span.End()
+	return is.s.GetTicketWithStatus(ctx, id)
+}
+
+func (is *instrumentedService) GetTicketsWithStatus(ctx context.Context, ids []string) ([]*pb.Ticket, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetTicketsWithStatus")
+	defer span.End()
+	return is.s.GetTicketsWithStatus(ctx, ids)
+}
+
+func (is *instrumentedService) WatchTicket(ctx context.Context, id string, callback func(*pb.Ticket) error) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.WatchTicket")
+	defer span.End()
+	return is.s.WatchTicket(ctx, id, callback)
+}
+
 func (is *instrumentedService) DeleteTicket(ctx context.Context, id string) error {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.DeleteTicket")
 	defer span.End()
 	return is.s.DeleteTicket(ctx, id)
 }
 
+func (is *instrumentedService) DeleteTickets(ctx context.Context, ids []string) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.DeleteTickets")
+	defer span.End()
+	return is.s.DeleteTickets(ctx, ids)
+}
+
 func (is *instrumentedService) IndexTicket(ctx context.Context, ticket *pb.Ticket) error {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.IndexTicket")
 	defer span.End()
 	return is.s.IndexTicket(ctx, ticket)
 }
 
+func (is *instrumentedService) UpdateTicket(ctx context.Context, ticket *pb.Ticket) (*pb.Ticket, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.UpdateTicket")
+	defer span.End()
+	return is.s.UpdateTicket(ctx, ticket)
+}
+
+func (is *instrumentedService) GetTicketGenerations(ctx context.Context) (map[string]int64, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetTicketGenerations")
+	defer span.End()
+	return is.s.GetTicketGenerations(ctx)
+}
+
 func (is *instrumentedService) DeindexTicket(ctx context.Context, id string) error {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.DeindexTicket")
 	defer span.End()
 	return is.s.DeindexTicket(ctx, id)
 }
 
+func (is *instrumentedService) DeindexTickets(ctx context.Context, ids []string) ([]error, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.DeindexTickets")
+	defer span.End()
+	return is.s.DeindexTickets(ctx, ids)
+}
+
 func (is *instrumentedService) GetTickets(ctx context.Context, ids []string) ([]*pb.Ticket, error) {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetTickets")
 	defer span.End()
@@ -77,6 +132,12 @@ func (is *instrumentedService) GetIndexedIDSet(ctx context.Context) (map[string]
 	return is.s.GetIndexedIDSet(ctx)
 }
 
+func (is *instrumentedService) CountIndexedTickets(ctx context.Context) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.CountIndexedTickets")
+	defer span.End()
+	return is.s.CountIndexedTickets(ctx)
+}
+
 func (is *instrumentedService) UpdateAssignments(ctx context.Context, req *pb.AssignTicketsRequest) (*pb.AssignTicketsResponse, []*pb.Ticket, error) {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.UpdateAssignments")
 	defer span.End()
@@ -89,10 +150,16 @@ func (is *instrumentedService) GetAssignments(ctx context.Context, id string, ca
 	return is.s.GetAssignments(ctx, id, callback)
 }
 
-func (is *instrumentedService) AddTicketsToPendingRelease(ctx context.Context, ids []string) error {
+func (is *instrumentedService) AddTicketsToPendingRelease(ctx context.Context, ids []string, timeout time.Duration) error {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.AddTicketsToPendingRelease")
 	defer span.End()
-	return is.s.AddTicketsToPendingRelease(ctx, ids)
+	return is.s.AddTicketsToPendingRelease(ctx, ids, timeout)
+}
+
+func (is *instrumentedService) ExtendPendingRelease(ctx context.Context, ids []string, timeout time.Duration) ([]string, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.ExtendPendingRelease")
+	defer span.End()
+	return is.s.ExtendPendingRelease(ctx, ids, timeout)
 }
 
 func (is *instrumentedService) DeleteTicketsFromPendingRelease(ctx context.Context, ids []string) error {
@@ -149,6 +216,20 @@ func (is *instrumentedService) NewMutex(key string) RedisLocker {
 	return is.s.NewMutex(key)
 }
 
+// NewLeaderMutex returns a new distributed mutex with given name, which holds value while locked
+func (is *instrumentedService) NewLeaderMutex(key, value string, expiry time.Duration) RedisLocker {
+	_, span := trace.StartSpan(context.Background(), "statestore/instrumented.NewLeaderMutex")
+	defer span.End()
+	return is.s.NewLeaderMutex(key, value, expiry)
+}
+
+// GetLockHolder returns the value held by the distributed mutex with given name.
+func (is *instrumentedService) GetLockHolder(ctx context.Context, key string) (string, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetLockHolder")
+	defer span.End()
+	return is.s.GetLockHolder(ctx, key)
+}
+
 // UpdateAcknowledgmentTimestamp stores Backfill's last acknowledged time
 func (is *instrumentedService) UpdateAcknowledgmentTimestamp(ctx context.Context, id string) error {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.UpdateAcknowledgmentTimestamp")
@@ -197,3 +278,57 @@ func (is *instrumentedService) DeleteBackfillCompletely(ctx context.Context, id
 	defer span.End()
 	return is.s.DeleteBackfillCompletely(ctx, id)
 }
+
+func (is *instrumentedService) CreateParty(ctx context.Context, party *pb.Party, tickets []*pb.Ticket) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.CreateParty")
+	defer span.End()
+	return is.s.CreateParty(ctx, party, tickets)
+}
+
+func (is *instrumentedService) GetParty(ctx context.Context, id string) (*pb.Party, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetParty")
+	defer span.End()
+	return is.s.GetParty(ctx, id)
+}
+
+func (is *instrumentedService) DeleteParty(ctx context.Context, id string) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.DeleteParty")
+	defer span.End()
+	return is.s.DeleteParty(ctx, id)
+}
+
+func (is *instrumentedService) CreateMatchRecord(ctx context.Context, record *pb.MatchRecord) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.CreateMatchRecord")
+	defer span.End()
+	return is.s.CreateMatchRecord(ctx, record)
+}
+
+func (is *instrumentedService) GetMatchRecord(ctx context.Context, id string) (*pb.MatchRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetMatchRecord")
+	defer span.End()
+	return is.s.GetMatchRecord(ctx, id)
+}
+
+func (is *instrumentedService) ListMatchRecords(ctx context.Context, profile string, createdAfter, createdBefore time.Time, pageSize int, pageToken string) ([]*pb.MatchRecord, string, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.ListMatchRecords")
+	defer span.End()
+	return is.s.ListMatchRecords(ctx, profile, createdAfter, createdBefore, pageSize, pageToken)
+}
+
+func (is *instrumentedService) UpdateMatchRecordAssignments(ctx context.Context, tickets []*pb.Ticket) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.UpdateMatchRecordAssignments")
+	defer span.End()
+	return is.s.UpdateMatchRecordAssignments(ctx, tickets)
+}
+
+func (is *instrumentedService) AddWaitTimes(ctx context.Context, waits []WaitTime, maxSamples int, window time.Duration) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.AddWaitTimes")
+	defer span.End()
+	return is.s.AddWaitTimes(ctx, waits, maxSamples, window)
+}
+
+func (is *instrumentedService) GetWaitTimes(ctx context.Context, bucket string, window time.Duration) ([]time.Duration, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetWaitTimes")
+	defer span.End()
+	return is.s.GetWaitTimes(ctx, bucket, window)
+}
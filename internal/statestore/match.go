@@ -0,0 +1,391 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	allMatches = "allMatches"
+)
+
+func matchRecordKey(id string) string {
+	return "match/" + id
+}
+
+func ticketMatchKey(ticketID string) string {
+	return "ticket_match/" + ticketID
+}
+
+// profileMatchesKey is the index of the matches of a profile, alongside the index of all matches.
+func profileMatchesKey(profile string) string {
+	return "profile_matches/" + profile
+}
+
+// getMatchRecordTimeout returns how long match records are kept, which defaults to the assignedDeleteTimeout.
+func getMatchRecordTimeout(cfg config.View) time.Duration {
+	if ttl := cfg.GetDuration("matchRecordTimeout"); ttl > 0 {
+		return ttl
+	}
+	return cfg.GetDuration("assignedDeleteTimeout")
+}
+
+// CreateMatchRecord persists the MatchRecord and associates each of its tickets with it.
+// Records expire after the configured matchRecordTimeout, which defaults to the assignedDeleteTimeout.
+// It fails with AlreadyExists if a record with the same match id exists, without touching it or its tickets.
+func (rb *redisBackend) CreateMatchRecord(ctx context.Context, record *pb.MatchRecord) error {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "CreateMatchRecord, id: %s, failed to connect to redis: %v", record.GetMatchId(), err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	createTime, err := ptypes.Timestamp(record.GetCreateTime())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid create time for match record, id: %s: %v", record.GetMatchId(), err)
+	}
+
+	value, err := proto.Marshal(record)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to marshal the match record proto, id: %s", record.GetMatchId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	ttl := getMatchRecordTimeout(rb.cfg)
+	ttlMs := int64(ttl / time.Millisecond)
+
+	// The record key is watched, so that a record created concurrently with the same id aborts the transaction.
+	_, err = redisConn.Do("WATCH", matchRecordKey(record.GetMatchId()))
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error watching match record"))
+	}
+	exists, err := redis.Bool(redisConn.Do("EXISTS", matchRecordKey(record.GetMatchId())))
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "failed to check for match record, id: %s", record.GetMatchId()))
+	}
+	if exists {
+		_, err = redisConn.Do("UNWATCH")
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error unwatching match record"))
+		}
+		return status.Errorf(codes.AlreadyExists, "Match id: %s already exists", record.GetMatchId())
+	}
+
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error starting redis multi"))
+	}
+	err = redisConn.Send("SET", matchRecordKey(record.GetMatchId()), value, "PX", ttlMs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending match record set"))
+	}
+	for _, id := range record.GetTicketIds() {
+		err = redisConn.Send("SET", ticketMatchKey(id), record.GetMatchId(), "PX", ttlMs)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket match set"))
+		}
+	}
+	indexes := []string{allMatches, profileMatchesKey(record.GetMatchProfile())}
+	for _, index := range indexes {
+		err = redisConn.Send("ZADD", index, createTime.UnixNano(), record.GetMatchId())
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending match index add"))
+		}
+		// Drop index entries whose records have already expired.
+		err = redisConn.Send("ZREMRANGEBYSCORE", index, "-inf", time.Now().Add(-ttl).UnixNano())
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending match index cleanup"))
+		}
+	}
+	// The index of a profile which stops making matches expires along with its last record.
+	err = redisConn.Send("PEXPIRE", profileMatchesKey(record.GetMatchProfile()), ttlMs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending match index expiry"))
+	}
+
+	_, err = redis.Values(redisConn.Do("EXEC"))
+	if err == redis.ErrNil {
+		return status.Errorf(codes.AlreadyExists, "Match id: %s already exists", record.GetMatchId())
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "failed to create match record, id: %s", record.GetMatchId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return nil
+}
+
+// GetMatchRecord gets the MatchRecord with the specified match id from state storage.
+// This method fails if the record does not exist or has expired.
+func (rb *redisBackend) GetMatchRecord(ctx context.Context, id string) (*pb.MatchRecord, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "GetMatchRecord, id: %s, failed to connect to redis: %v", id, err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	value, err := redis.Bytes(redisConn.Do("GET", matchRecordKey(id)))
+	if err != nil {
+		// Return NotFound if redigo did not find the record in storage.
+		if err == redis.ErrNil {
+			return nil, status.Errorf(codes.NotFound, "Match id: %s not found", id)
+		}
+
+		err = errors.Wrapf(err, "failed to get the match record from state storage, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	record := &pb.MatchRecord{}
+	err = proto.Unmarshal(value, record)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to unmarshal match record, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return record, nil
+}
+
+// ListMatchRecords returns up to pageSize MatchRecords of the profile, or of all profiles if empty, created within
+// [createdAfter, createdBefore], sorted by create time. A zero time leaves that side of the range unbounded.
+// The records after the ones of pageToken are returned, along with the token of the next page, which is empty
+// on the last page. Expired records are skipped, so pages may have fewer records.
+func (rb *redisBackend) ListMatchRecords(ctx context.Context, profile string, createdAfter, createdBefore time.Time, pageSize int, pageToken string) ([]*pb.MatchRecord, string, error) {
+	var min, max interface{} = "-inf", "+inf"
+	if !createdAfter.IsZero() {
+		min = createdAfter.UnixNano()
+	}
+	if !createdBefore.IsZero() {
+		max = createdBefore.UnixNano()
+	}
+
+	var last *matchCursor
+	if pageToken != "" {
+		var err error
+		last, err = decodeMatchCursor(pageToken)
+		if err != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		// The last match of the previous page is skipped below, along with the ones before it of the same score.
+		min = last.score
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Unavailable, "ListMatchRecords, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	index := allMatches
+	if profile != "" {
+		index = profileMatchesKey(profile)
+	}
+
+	// One more match than the page size is read to know whether there is a next page.
+	cursors := make([]*matchCursor, 0, pageSize+1)
+	for offset := 0; len(cursors) <= pageSize; {
+		values, err := redis.Strings(redisConn.Do("ZRANGEBYSCORE", index, min, max, "WITHSCORES", "LIMIT", offset, pageSize+1))
+		if err != nil {
+			return nil, "", status.Errorf(codes.Internal, "error getting match ids %v", err)
+		}
+		for i := 0; i+1 < len(values) && len(cursors) <= pageSize; i += 2 {
+			c := &matchCursor{id: values[i], score: values[i+1]}
+			if last != nil && !last.before(c) {
+				continue
+			}
+			cursors = append(cursors, c)
+		}
+		if len(values) < 2*(pageSize+1) {
+			break
+		}
+		offset += pageSize + 1
+	}
+
+	nextPageToken := ""
+	if len(cursors) > pageSize {
+		cursors = cursors[:pageSize]
+		nextPageToken = cursors[len(cursors)-1].encode()
+	}
+	if len(cursors) == 0 {
+		return nil, nextPageToken, nil
+	}
+
+	queryParams := make([]interface{}, len(cursors))
+	for i, c := range cursors {
+		queryParams[i] = matchRecordKey(c.id)
+	}
+
+	slices, err := redis.ByteSlices(redisConn.Do("MGET", queryParams...))
+	if err != nil {
+		err = errors.Wrap(err, "failed to lookup match records")
+		return nil, "", status.Errorf(codes.Internal, "%v", err)
+	}
+
+	records := make([]*pb.MatchRecord, 0, len(slices))
+	for i, s := range slices {
+		// Expired records are silently ignored.
+		if s == nil {
+			continue
+		}
+		r := &pb.MatchRecord{}
+		err = proto.Unmarshal(s, r)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to unmarshal match record from redis, id: %s", cursors[i].id)
+			return nil, "", status.Errorf(codes.Internal, "%v", err)
+		}
+		records = append(records, r)
+	}
+
+	return records, nextPageToken, nil
+}
+
+// matchCursor is the position of a match in a match index, which is sorted by score, and by id for equal scores.
+type matchCursor struct {
+	id    string
+	score string
+}
+
+func (c *matchCursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.score + "/" + c.id))
+}
+
+func decodeMatchCursor(token string) (*matchCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(string(b), "/", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("malformed token")
+	}
+	if _, err = strconv.ParseFloat(parts[0], 64); err != nil {
+		return nil, errors.Wrap(err, "malformed token")
+	}
+	return &matchCursor{score: parts[0], id: parts[1]}, nil
+}
+
+// before returns whether the match of c comes before the match of other in the index.
+func (c *matchCursor) before(other *matchCursor) bool {
+	a, _ := strconv.ParseFloat(c.score, 64)
+	b, _ := strconv.ParseFloat(other.score, 64)
+	if a != b {
+		return a < b
+	}
+	return c.id < other.id
+}
+
+// UpdateMatchRecordAssignments sets the assignment of the MatchRecords the given tickets belong to.
+// Tickets which are not part of a persisted match are silently ignored.
+func (rb *redisBackend) UpdateMatchRecordAssignments(ctx context.Context, tickets []*pb.Ticket) error {
+	if len(tickets) == 0 {
+		return nil
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "UpdateMatchRecordAssignments, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	queryParams := make([]interface{}, len(tickets))
+	for i, t := range tickets {
+		queryParams[i] = ticketMatchKey(t.GetId())
+	}
+
+	matchIDs, err := redis.Strings(redisConn.Do("MGET", queryParams...))
+	if err != nil {
+		err = errors.Wrap(err, "failed to lookup matches of tickets")
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	idToA := make(map[string]*pb.Assignment)
+	ids := make([]interface{}, 0, len(matchIDs))
+	for i, id := range matchIDs {
+		if id == "" {
+			continue
+		}
+		if _, ok := idToA[id]; !ok {
+			ids = append(ids, matchRecordKey(id))
+		}
+		idToA[id] = tickets[i].GetAssignment()
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	slices, err := redis.ByteSlices(redisConn.Do("MGET", ids...))
+	if err != nil {
+		err = errors.Wrap(err, "failed to lookup match records")
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	ttl := getMatchRecordTimeout(rb.cfg)
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error starting redis multi"))
+	}
+
+	for _, s := range slices {
+		if s == nil {
+			continue
+		}
+		r := &pb.MatchRecord{}
+		err = proto.Unmarshal(s, r)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to unmarshal match record"))
+		}
+
+		createTime, err := ptypes.Timestamp(r.GetCreateTime())
+		if err != nil {
+			return status.Errorf(codes.Internal, "invalid create time for match record, id: %s: %v", r.GetMatchId(), err)
+		}
+		// Keep the original expiry so that assigning a match does not extend its lifetime.
+		remaining := time.Until(createTime.Add(ttl))
+		if remaining < time.Millisecond {
+			continue
+		}
+
+		r.Assignment = idToA[r.GetMatchId()]
+		value, err := proto.Marshal(r)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to marshal match record %s", r.GetMatchId())
+		}
+
+		err = redisConn.Send("SET", matchRecordKey(r.GetMatchId()), value, "PX", int64(remaining/time.Millisecond), "XX")
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending match record set"))
+		}
+	}
+
+	_, err = redisConn.Do("EXEC")
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error executing match record assignment set"))
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestCreateAndGetMatchRecord(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	record := &pb.MatchRecord{
+		MatchId:       "match-1",
+		MatchProfile:  "profile",
+		MatchFunction: "mmf",
+		TicketIds:     []string{"t1", "t2"},
+		BackfillId:    "bf",
+		CreateTime:    ptypes.TimestampNow(),
+	}
+	require.NoError(t, service.CreateMatchRecord(ctx, record))
+
+	actual, err := service.GetMatchRecord(ctx, record.MatchId)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(record, actual))
+
+	_, err = service.GetMatchRecord(ctx, "unknown")
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+}
+
+func TestCreateMatchRecordSameID(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	first := &pb.MatchRecord{MatchId: "match-1", TicketIds: []string{"t1"}, CreateTime: ptypes.TimestampNow()}
+	require.NoError(t, service.CreateMatchRecord(ctx, first))
+
+	// A later match reusing the id neither replaces the record, nor takes over its tickets.
+	err := service.CreateMatchRecord(ctx, &pb.MatchRecord{MatchId: "match-1", TicketIds: []string{"t2"}, CreateTime: ptypes.TimestampNow()})
+	require.Equal(t, codes.AlreadyExists.String(), status.Convert(err).Code().String())
+
+	actual, err := service.GetMatchRecord(ctx, "match-1")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(first, actual))
+
+	require.NoError(t, service.UpdateMatchRecordAssignments(ctx, []*pb.Ticket{{Id: "t2", Assignment: &pb.Assignment{Connection: "other"}}}))
+	actual, err = service.GetMatchRecord(ctx, "match-1")
+	require.NoError(t, err)
+	require.Nil(t, actual.GetAssignment())
+}
+
+func TestListMatchRecords(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	now := time.Now()
+	for i, id := range []string{"m1", "m2", "m3"} {
+		ts, err := ptypes.TimestampProto(now.Add(time.Duration(i-3) * time.Millisecond))
+		require.NoError(t, err)
+		require.NoError(t, service.CreateMatchRecord(ctx, &pb.MatchRecord{MatchId: id, MatchProfile: "p" + id, CreateTime: ts}))
+	}
+
+	records, token, err := service.ListMatchRecords(ctx, "", time.Time{}, time.Time{}, 10, "")
+	require.NoError(t, err)
+	require.Empty(t, token)
+	require.Len(t, records, 3)
+	for i, id := range []string{"m1", "m2", "m3"} {
+		require.Equal(t, id, records[i].MatchId)
+	}
+
+	records, _, err = service.ListMatchRecords(ctx, "", now.Add(-2*time.Millisecond), time.Time{}, 10, "")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "m2", records[0].MatchId)
+
+	records, _, err = service.ListMatchRecords(ctx, "", time.Time{}, now.Add(-3*time.Millisecond), 10, "")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "m1", records[0].MatchId)
+
+	records, _, err = service.ListMatchRecords(ctx, "pm2", time.Time{}, time.Time{}, 10, "")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "m2", records[0].MatchId)
+}
+
+func TestListMatchRecordsPages(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	// Matches created at the same time are paged by id.
+	ts := ptypes.TimestampNow()
+	for _, id := range []string{"m5", "m1", "m4", "m2", "m3"} {
+		require.NoError(t, service.CreateMatchRecord(ctx, &pb.MatchRecord{MatchId: id, CreateTime: ts}))
+	}
+
+	var ids []string
+	token := ""
+	for pages := 0; pages == 0 || token != ""; pages++ {
+		require.Less(t, pages, 3)
+		var records []*pb.MatchRecord
+		var err error
+		records, token, err = service.ListMatchRecords(ctx, "", time.Time{}, time.Time{}, 2, token)
+		require.NoError(t, err)
+		for _, r := range records {
+			ids = append(ids, r.MatchId)
+		}
+	}
+	require.Equal(t, []string{"m1", "m2", "m3", "m4", "m5"}, ids)
+
+	_, _, err := service.ListMatchRecords(ctx, "", time.Time{}, time.Time{}, 2, "invalid")
+	require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
+}
+
+func TestMatchRecordTimeoutDefault(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	cfg.(config.Mutable).Set("matchRecordTimeout", 0)
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	require.NoError(t, service.CreateMatchRecord(ctx, &pb.MatchRecord{MatchId: "m1", CreateTime: ptypes.TimestampNow()}))
+	records, _, err := service.ListMatchRecords(ctx, "", time.Time{}, time.Time{}, 10, "")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+}
+
+func TestUpdateMatchRecordAssignments(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	require.NoError(t, service.CreateMatchRecord(ctx, &pb.MatchRecord{
+		MatchId:    "match-1",
+		TicketIds:  []string{"t1", "t2"},
+		CreateTime: ptypes.TimestampNow(),
+	}))
+
+	a := &pb.Assignment{Connection: "1.2.3.4:5"}
+	err := service.UpdateMatchRecordAssignments(ctx, []*pb.Ticket{
+		{Id: "t1", Assignment: a},
+		{Id: "unknown", Assignment: a},
+	})
+	require.NoError(t, err)
+
+	record, err := service.GetMatchRecord(ctx, "match-1")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(a, record.Assignment))
+}
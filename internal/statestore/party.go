@@ -0,0 +1,161 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func partyKey(id string) string {
+	return "party/" + id
+}
+
+// CreateParty creates the Party and its member Tickets, and indexes the Tickets, in a single transaction.
+func (rb *redisBackend) CreateParty(ctx context.Context, party *pb.Party, tickets []*pb.Ticket) error {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "CreateParty, id: %s, failed to connect to redis: %v", party.GetId(), err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	value, err := proto.Marshal(party)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to marshal the party proto, id: %s", party.GetId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error starting redis multi"))
+	}
+	for _, ticket := range tickets {
+		ticketValue, err := proto.Marshal(ticket)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to marshal the ticket proto, id: %s", ticket.GetId())
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+		err = redisConn.Send("SET", ticket.GetId(), ticketValue)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket set"))
+		}
+		err = redisConn.Send("SADD", allTickets, ticket.GetId())
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket index add"))
+		}
+	}
+	err = redisConn.Send("SET", partyKey(party.GetId()), value)
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending party set"))
+	}
+
+	_, err = redisConn.Do("EXEC")
+	if err != nil {
+		err = errors.Wrapf(err, "failed to create party, id: %s", party.GetId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return nil
+}
+
+// GetParty gets the Party with the specified id from state storage. This method fails if the Party does not exist.
+func (rb *redisBackend) GetParty(ctx context.Context, id string) (*pb.Party, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "GetParty, id: %s, failed to connect to redis: %v", id, err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	value, err := redis.Bytes(redisConn.Do("GET", partyKey(id)))
+	if err != nil {
+		// Return NotFound if redigo did not find the party in storage.
+		if err == redis.ErrNil {
+			return nil, status.Errorf(codes.NotFound, "Party id: %s not found", id)
+		}
+
+		err = errors.Wrapf(err, "failed to get the party from state storage, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	party := &pb.Party{}
+	err = proto.Unmarshal(value, party)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to unmarshal the party proto, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return party, nil
+}
+
+// DeleteParty removes the Party with the specified id and its member Tickets from state storage, deindexing the
+// Tickets and releasing them from the pending release, in a single transaction.
+// This method fails if the Party does not exist.
+func (rb *redisBackend) DeleteParty(ctx context.Context, id string) error {
+	party, err := rb.GetParty(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "DeleteParty, id: %s, failed to connect to redis: %v", id, err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error starting redis multi"))
+	}
+	for _, ticketID := range party.GetTicketIds() {
+		err = redisConn.Send("SREM", allTickets, ticketID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket index remove"))
+		}
+		err = redisConn.Send("ZREM", proposedTicketIDs, ticketID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending pending release remove"))
+		}
+		err = redisConn.Send("HDEL", ticketGenerations, ticketID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket generation delete"))
+		}
+		err = redisConn.Send("DEL", ticketID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket delete"))
+		}
+		err = rb.sendTicketTombstone(redisConn, ticketID, pb.Ticket_DELETED, 0)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+	}
+	err = redisConn.Send("DEL", partyKey(id))
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending party delete"))
+	}
+
+	_, err = redisConn.Do("EXEC")
+	if err != nil {
+		err = errors.Wrapf(err, "failed to delete party, id: %s", id)
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return nil
+}
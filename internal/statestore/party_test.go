@@ -0,0 +1,114 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestCreateGetAndDeleteParty(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	party := &pb.Party{
+		Id:         "party",
+		TicketIds:  []string{"t1", "t2"},
+		CreateTime: ptypes.TimestampNow(),
+	}
+	tickets := []*pb.Ticket{
+		{Id: "t1", PartyId: "party", PartySize: 2},
+		{Id: "t2", PartyId: "party", PartySize: 2},
+	}
+	require.NoError(t, service.CreateParty(ctx, party, tickets))
+
+	actual, err := service.GetParty(ctx, party.Id)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(party, actual))
+
+	ticket, err := service.GetTicket(ctx, "t2")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(tickets[1], ticket))
+
+	indexed, err := service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Contains(t, indexed, "t1")
+	require.Contains(t, indexed, "t2")
+
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, []string{"t1"}, time.Minute))
+	require.NoError(t, service.DeleteParty(ctx, party.Id))
+
+	indexed, err = service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Empty(t, indexed)
+	for _, id := range party.TicketIds {
+		_, err = service.GetTicket(ctx, id)
+		require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+	}
+
+	_, err = service.GetParty(ctx, party.Id)
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+	err = service.DeleteParty(ctx, party.Id)
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+}
+
+func TestPartyExpiresWithAssignedTickets(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	party := &pb.Party{Id: "party", TicketIds: []string{"t1", "t2"}}
+	tickets := []*pb.Ticket{
+		{Id: "t1", PartyId: "party", PartySize: 2},
+		{Id: "t2", PartyId: "party", PartySize: 2},
+	}
+	require.NoError(t, service.CreateParty(ctx, party, tickets))
+
+	rb, ok := service.(*instrumentedService).s.(*redisBackend)
+	require.True(t, ok)
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	require.NoError(t, err)
+	defer handleConnectionClose(&redisConn)
+
+	ttl, err := redis.Int64(redisConn.Do("PTTL", partyKey(party.Id)))
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), ttl)
+
+	_, _, err = service.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{TicketIds: party.TicketIds, Assignment: &pb.Assignment{Connection: "c"}}},
+	})
+	require.NoError(t, err)
+
+	ttl, err = redis.Int64(redisConn.Do("PTTL", partyKey(party.Id)))
+	require.NoError(t, err)
+	require.Greater(t, ttl, int64(0))
+	require.LessOrEqual(t, ttl, int64(cfg.GetDuration("assignedDeleteTimeout")/time.Millisecond))
+}
@@ -16,6 +16,7 @@ package statestore
 
 import (
 	"context"
+	"time"
 
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/telemetry"
@@ -35,23 +36,65 @@ type Service interface {
 	// CreateTicket creates a new Ticket in the state storage. If the id already exists, it will be overwritten.
 	CreateTicket(ctx context.Context, ticket *pb.Ticket) error
 
+	// CreateTicketIdempotent creates and indexes the Ticket, unless a Ticket of the same owner was already
+	// created with the idempotency key within the ticketIdempotencyWindow. It returns the current state of the
+	// Ticket created by the first call with the key, or NotFound if that Ticket was deleted or has expired.
+	CreateTicketIdempotent(ctx context.Context, ticket *pb.Ticket, key string) (*pb.Ticket, error)
+
+	// CreateTickets creates and indexes multiple Tickets using a single round trip.
+	// The returned errors are in the order of tickets, and nil for the Tickets which were created.
+	CreateTickets(ctx context.Context, tickets []*pb.Ticket) ([]error, error)
+
 	// GetTicket gets the Ticket with the specified id from state storage.
 	// This method fails if the Ticket does not exist.
 	GetTicket(ctx context.Context, id string) (*pb.Ticket, error)
 
+	// GetTicketWithStatus gets the Ticket with the specified id from state storage, with its Status set.
+	// Tickets which were recently deleted or have expired are returned with only their id and Status set.
+	// This method fails if the Ticket does not exist.
+	GetTicketWithStatus(ctx context.Context, id string) (*pb.Ticket, error)
+
+	// GetTicketsWithStatus gets multiple Tickets from state storage, with their Status set, using a single round trip.
+	// Tickets which were recently deleted or have expired are returned with only their id and Status set.
+	// Tickets which do not exist are skipped.
+	GetTicketsWithStatus(ctx context.Context, ids []string) ([]*pb.Ticket, error)
+
+	// WatchTicket calls the callback with the Ticket, with its Status set, whenever the Status changes.
+	// It returns after the callback was called with a DELETED or EXPIRED Ticket.
+	WatchTicket(ctx context.Context, id string, callback func(*pb.Ticket) error) error
+
 	// DeleteTicket removes the Ticket with the specified id from state storage.
 	// This method succeeds if the Ticket does not exist.
 	DeleteTicket(ctx context.Context, id string) error
 
+	// DeleteTickets removes multiple Tickets from state storage using a single round trip.
+	// This method succeeds if some Tickets do not exist.
+	DeleteTickets(ctx context.Context, ids []string) error
+
+	// UpdateTicket replaces the SearchFields and Extensions of the stored Ticket and increments its generation.
+	// This method fails if the Ticket does not exist, is not indexed, is pending release or is assigned.
+	UpdateTicket(ctx context.Context, ticket *pb.Ticket) (*pb.Ticket, error)
+
+	// GetTicketGenerations returns the generations of all tickets which were updated. Generations are removed
+	// when tickets are deindexed, assigned or deleted.
+	GetTicketGenerations(ctx context.Context) (map[string]int64, error)
+
 	// IndexTicket adds the ticket to the index.
 	IndexTicket(ctx context.Context, ticket *pb.Ticket) error
 
 	// DeindexTicket removes specified ticket from the index. The Ticket continues to exist.
 	DeindexTicket(ctx context.Context, id string) error
 
+	// DeindexTickets removes multiple tickets from the index using a single round trip. The Tickets continue to exist.
+	// The returned errors are in the order of ids, and nil for the Tickets which were deindexed.
+	DeindexTickets(ctx context.Context, ids []string) ([]error, error)
+
 	// GetIndexedIDSet returns the ids of all tickets currently indexed.
 	GetIndexedIDSet(ctx context.Context) (map[string]struct{}, error)
 
+	// CountIndexedTickets returns the number of tickets currently indexed, including pending ones.
+	CountIndexedTickets(ctx context.Context) (int, error)
+
 	// GetTickets returns multiple tickets from storage.
 	// Missing tickets are silently ignored.
 	GetTickets(ctx context.Context, ids []string) ([]*pb.Ticket, error)
@@ -63,7 +106,12 @@ type Service interface {
 	GetAssignments(ctx context.Context, id string, callback func(*pb.Assignment) error) error
 
 	// AddTicketsToPendingRelease appends new proposed tickets to the proposed sorted set with current timestamp.
-	AddTicketsToPendingRelease(ctx context.Context, ids []string) error
+	// If timeout is non zero, the tickets are released after timeout instead of the configured pendingReleaseTimeout.
+	AddTicketsToPendingRelease(ctx context.Context, ids []string, timeout time.Duration) error
+
+	// ExtendPendingRelease postpones the release of the pending tickets to timeout from now, or the configured
+	// pendingReleaseTimeout if timeout is zero. Returns the ids of the tickets which are not pending anymore.
+	ExtendPendingRelease(ctx context.Context, ids []string, timeout time.Duration) ([]string, error)
 
 	// DeleteTicketsFromPendingRelease deletes tickets from the proposed sorted set.
 	DeleteTicketsFromPendingRelease(ctx context.Context, ids []string) error
@@ -71,6 +119,19 @@ type Service interface {
 	// ReleaseAllTickets releases all pending tickets back to active.
 	ReleaseAllTickets(ctx context.Context) error
 
+	// Party
+
+	// CreateParty creates the Party and its member Tickets, and indexes the Tickets, in a single transaction.
+	CreateParty(ctx context.Context, party *pb.Party, tickets []*pb.Ticket) error
+
+	// GetParty gets the Party with the specified id from state storage.
+	// This method fails if the Party does not exist.
+	GetParty(ctx context.Context, id string) (*pb.Party, error)
+
+	// DeleteParty removes the Party and its member Tickets from state storage, deindexing the Tickets and
+	// releasing them from the pending release. This method fails if the Party does not exist.
+	DeleteParty(ctx context.Context, id string) error
+
 	// Backfill
 
 	// CreateBackfill creates a new Backfill in the state storage if one doesn't exist.
@@ -99,6 +160,14 @@ type Service interface {
 	// NewMutex returns an interface of a new distributed mutex with given name
 	NewMutex(key string) RedisLocker
 
+	// NewLeaderMutex returns an interface of a new distributed mutex with given name, which holds
+	// value while locked and expires after expiry unless extended. Its Lock does not retry.
+	NewLeaderMutex(key, value string, expiry time.Duration) RedisLocker
+
+	// GetLockHolder returns the value held by the distributed mutex with given name.
+	// This method fails with NotFound if the mutex is not locked.
+	GetLockHolder(ctx context.Context, key string) (string, error)
+
 	// CleanupBackfills removes expired backfills
 	CleanupBackfills(ctx context.Context) error
 
@@ -117,6 +186,35 @@ type Service interface {
 	// GetIndexedBackfills returns a map containing the IDs and
 	// the Generation number of the backfills currently indexed.
 	GetIndexedBackfills(ctx context.Context) (map[string]int, error)
+
+	// Match
+
+	// CreateMatchRecord persists a MatchRecord and associates its tickets with it.
+	// Records expire after the configured matchRecordTimeout, which defaults to the assignedDeleteTimeout.
+	// It fails with AlreadyExists if a record with the same match id exists.
+	CreateMatchRecord(ctx context.Context, record *pb.MatchRecord) error
+
+	// GetMatchRecord gets the MatchRecord with the specified match id from state storage.
+	// This method fails if the record does not exist.
+	GetMatchRecord(ctx context.Context, id string) (*pb.MatchRecord, error)
+
+	// ListMatchRecords returns up to pageSize MatchRecords of the profile, or of all profiles if empty, created
+	// within the given time range, sorted by create time. A zero time leaves that side of the range unbounded.
+	// The records after the ones of pageToken are returned, along with the token of the next page, which is
+	// empty on the last page.
+	ListMatchRecords(ctx context.Context, profile string, createdAfter, createdBefore time.Time, pageSize int, pageToken string) ([]*pb.MatchRecord, string, error)
+
+	// UpdateMatchRecordAssignments sets the assignment of the MatchRecords the given tickets belong to.
+	UpdateMatchRecordAssignments(ctx context.Context, tickets []*pb.Ticket) error
+
+	// Wait times
+
+	// AddWaitTimes records the times to assignment of Tickets in their buckets.
+	// Only the maxSamples most recent ones within the window are kept per bucket.
+	AddWaitTimes(ctx context.Context, waits []WaitTime, maxSamples int, window time.Duration) error
+
+	// GetWaitTimes returns the times to assignment recorded in the bucket within the window.
+	GetWaitTimes(ctx context.Context, bucket string, window time.Duration) ([]time.Duration, error)
 }
 
 // New creates a Service based on the configuration.
@@ -134,4 +232,5 @@ func New(cfg config.View) Service {
 type RedisLocker interface {
 	Lock(ctx context.Context) error
 	Unlock(ctx context.Context) (bool, error)
+	Extend(ctx context.Context) (bool, error)
 }
@@ -45,6 +45,37 @@ func (rb *redisBackend) NewMutex(key string) RedisLocker {
 	return redisBackend{mutex: m}
 }
 
+// NewLeaderMutex returns a new distributed mutex with given name, which holds value while locked and
+// expires after expiry unless extended. Lock does not retry, so it can be used to campaign for leadership.
+func (rb *redisBackend) NewLeaderMutex(key, value string, expiry time.Duration) RedisLocker {
+	m := redsync.NewMutex(fmt.Sprintf("lock/%s", key),
+		rs.WithExpiry(expiry),
+		rs.WithTries(1),
+		rs.WithGenValueFunc(func() (string, error) { return value, nil }),
+	)
+	return redisBackend{mutex: m}
+}
+
+// GetLockHolder returns the value held by the distributed mutex with given name.
+// This method fails with NotFound if the mutex is not locked.
+func (rb *redisBackend) GetLockHolder(ctx context.Context, key string) (string, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Unavailable, "GetLockHolder, key: %s, failed to connect to redis: %v", key, err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	value, err := redis.String(redisConn.Do("GET", fmt.Sprintf("lock/%s", key)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return "", status.Errorf(codes.NotFound, "lock %s is not held", key)
+		}
+		return "", status.Errorf(codes.Internal, "failed to get lock %s: %v", key, err)
+	}
+
+	return value, nil
+}
+
 //Lock locks r. In case it returns an error on failure, you may retry to acquire the lock by calling this method again.
 func (rb redisBackend) Lock(ctx context.Context) error {
 	return rb.mutex.LockContext(ctx)
@@ -55,6 +86,11 @@ func (rb redisBackend) Unlock(ctx context.Context) (bool, error) {
 	return rb.mutex.UnlockContext(ctx)
 }
 
+// Extend resets the expiry of r and returns the status of the extension.
+func (rb redisBackend) Extend(ctx context.Context) (bool, error) {
+	return rb.mutex.ExtendContext(ctx)
+}
+
 type redisBackend struct {
 	healthCheckPool *redis.Pool
 	redisPool       *redis.Pool
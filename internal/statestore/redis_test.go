@@ -2,8 +2,11 @@ package statestore
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	utilTesting "open-match.dev/open-match/internal/util/testing"
 	"open-match.dev/open-match/pkg/pb"
 )
@@ -31,3 +34,38 @@ func TestNewMutex(t *testing.T) {
 	require.True(t, b)
 
 }
+
+func TestLeaderMutex(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	_, err := service.GetLockHolder(ctx, "leader")
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+
+	first := service.NewLeaderMutex("leader", "first", time.Minute)
+	second := service.NewLeaderMutex("leader", "second", time.Minute)
+
+	require.NoError(t, first.Lock(ctx))
+	require.Error(t, second.Lock(ctx))
+
+	holder, err := service.GetLockHolder(ctx, "leader")
+	require.NoError(t, err)
+	require.Equal(t, "first", holder)
+
+	ok, err := first.Extend(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = first.Unlock(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, second.Lock(ctx))
+	holder, err = service.GetLockHolder(ctx, "leader")
+	require.NoError(t, err)
+	require.Equal(t, "second", holder)
+}
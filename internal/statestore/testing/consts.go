@@ -38,4 +38,5 @@ const (
 	// MaxElapsedTime is the maximum total retry time of a backoff stragegy
 	MaxElapsedTime        = 1000 * time.Millisecond
 	assignedDeleteTimeout = 200 * time.Millisecond
+	matchRecordTimeout    = 10 * time.Second
 )
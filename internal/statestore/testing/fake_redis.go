@@ -48,6 +48,7 @@ func New(t *testing.T, cfg config.Mutable) func() {
 	cfg.Set("backfillLockTimeout", "1m")
 	cfg.Set("pendingReleaseTimeout", pendingReleaseTimeout)
 	cfg.Set("assignedDeleteTimeout", assignedDeleteTimeout)
+	cfg.Set("matchRecordTimeout", matchRecordTimeout)
 	cfg.Set("backoff.initialInterval", InitialInterval)
 	cfg.Set("backoff.randFactor", RandFactor)
 	cfg.Set("backoff.multiplier", Multiplier)
@@ -17,6 +17,7 @@ package statestore
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/cenkalti/backoff"
@@ -25,14 +26,41 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/pkg/pb"
 )
 
 const (
 	allTickets        = "allTickets"
 	proposedTicketIDs = "proposed_ticket_ids"
+	ticketGenerations = "ticket_generations"
 )
 
+var (
+	errAssignmentConflict = errors.New("tickets were modified concurrently while being assigned")
+	errUpdateConflict     = errors.New("ticket was modified concurrently while being updated")
+	errCreateConflict     = errors.New("ticket was created concurrently with the same idempotency key")
+)
+
+func assignmentIdempotencyKey(key string) string {
+	return "assign_idempotency/" + key
+}
+
+// createTicketIdempotencyKey scopes the key to the owner of the Ticket, so that callers cannot see the
+// Tickets of others by guessing their keys. The owner is quoted to keep it apart from the key.
+func createTicketIdempotencyKey(owner, key string) string {
+	return "create_ticket_idempotency/" + strconv.Quote(owner) + "/" + key
+}
+
+// getTicketIdempotencyWindow returns how long create ticket idempotency keys are kept, which defaults to the
+// assignedDeleteTimeout.
+func getTicketIdempotencyWindow(cfg config.View) time.Duration {
+	if window := cfg.GetDuration("ticketIdempotencyWindow"); window > 0 {
+		return window
+	}
+	return cfg.GetDuration("assignedDeleteTimeout")
+}
+
 // CreateTicket creates a new Ticket in the state storage. If the id already exists, it will be overwritten.
 func (rb *redisBackend) CreateTicket(ctx context.Context, ticket *pb.Ticket) error {
 	redisConn, err := rb.redisPool.GetContext(ctx)
@@ -56,6 +84,173 @@ func (rb *redisBackend) CreateTicket(ctx context.Context, ticket *pb.Ticket) err
 	return nil
 }
 
+// CreateTicketIdempotent creates and indexes the Ticket, unless a Ticket of the same owner was already
+// created with the idempotency key within the ticketIdempotencyWindow. It returns the current state of the
+// Ticket created by the first call with the key, or NotFound if that Ticket was deleted or has expired.
+func (rb *redisBackend) CreateTicketIdempotent(ctx context.Context, ticket *pb.Ticket, key string) (*pb.Ticket, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "CreateTicketIdempotent, id: %s, failed to connect to redis: %v", ticket.GetId(), err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	value, err := proto.Marshal(ticket)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to marshal the ticket proto, id: %s", ticket.GetId())
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var created *pb.Ticket
+	backoffOperation := func() error {
+		created, err = rb.tryCreateTicketIdempotent(redisConn, ticket, value, key)
+		if err == errCreateConflict {
+			return err
+		}
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		return nil
+	}
+
+	err = backoff.Retry(backoffOperation, rb.newExponentialBackoffStrategy())
+	if err == errCreateConflict {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// tryCreateTicketIdempotent makes a single compare-and-set attempt at creating the ticket. The idempotency
+// key is watched, so errCreateConflict is returned if another call set it during the attempt.
+func (rb *redisBackend) tryCreateTicketIdempotent(redisConn redis.Conn, ticket *pb.Ticket, value []byte, key string) (*pb.Ticket, error) {
+	idempotencyKey := createTicketIdempotencyKey(ticket.GetOwner(), key)
+	_, err := redisConn.Do("WATCH", idempotencyKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error watching idempotency key"))
+	}
+
+	id, err := redis.String(redisConn.Do("GET", idempotencyKey))
+	if err == nil {
+		// The ticket was already created, return its current state rather than the one it was created with.
+		_, err = redisConn.Do("UNWATCH")
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error unwatching idempotency key"))
+		}
+		return rb.getCreatedTicket(redisConn, id)
+	}
+	if err != redis.ErrNil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error getting idempotency key"))
+	}
+
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error starting redis multi"))
+	}
+	err = redisConn.Send("SET", ticket.GetId(), value)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket set"))
+	}
+	err = redisConn.Send("SADD", allTickets, ticket.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket index add"))
+	}
+	window := getTicketIdempotencyWindow(rb.cfg)
+	err = redisConn.Send("SET", idempotencyKey, ticket.GetId(), "PX", int64(window/time.Millisecond))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending idempotency key set"))
+	}
+
+	_, err = redis.Values(redisConn.Do("EXEC"))
+	if err == redis.ErrNil {
+		return nil, errCreateConflict
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "failed to create ticket, id: %s", ticket.GetId())
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return ticket, nil
+}
+
+// getCreatedTicket gets the Ticket created with an idempotency key, or NotFound if it was deleted or has
+// expired since.
+func (rb *redisBackend) getCreatedTicket(redisConn redis.Conn, id string) (*pb.Ticket, error) {
+	replies, err := rb.execTicketStatusQueries(redisConn, []string{id})
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get the ticket status, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	ticket, err := rb.ticketWithStatus(id, replies)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil || ticket.Status == pb.Ticket_DELETED || ticket.Status == pb.Ticket_EXPIRED {
+		return nil, status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+	}
+	// Same as the Ticket returned by the first call, the Status is only used to tell whether it is gone.
+	ticket.Status = pb.Ticket_UNKNOWN
+	return ticket, nil
+}
+
+// CreateTickets creates and indexes multiple Tickets in a single pipeline. If an id already exists, it will be overwritten.
+// The returned errors are in the order of tickets, and nil for the Tickets which were created.
+func (rb *redisBackend) CreateTickets(ctx context.Context, tickets []*pb.Ticket) ([]error, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "CreateTickets, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	errs := make([]error, len(tickets))
+	sent := make([]bool, len(tickets))
+	for i, ticket := range tickets {
+		value, err := proto.Marshal(ticket)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to marshal the ticket proto, id: %s", ticket.GetId())
+			errs[i] = status.Errorf(codes.Internal, "%v", err)
+			continue
+		}
+
+		err = redisConn.Send("SET", ticket.GetId(), value)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket set"))
+		}
+		err = redisConn.Send("SADD", allTickets, ticket.GetId())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket index add"))
+		}
+		sent[i] = true
+	}
+
+	err = redisConn.Flush()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to flush the ticket pipeline"))
+	}
+
+	for i, ticket := range tickets {
+		if !sent[i] {
+			continue
+		}
+		for _, op := range []string{"set the value for", "index"} {
+			_, err = redisConn.Receive()
+			if err == nil {
+				continue
+			}
+			if _, ok := err.(redis.Error); !ok {
+				err = errors.Wrapf(err, "failed to create tickets")
+				return nil, status.Errorf(codes.Internal, "%v", err)
+			}
+			if errs[i] == nil {
+				err = errors.Wrapf(err, "failed to %s ticket, id: %s", op, ticket.GetId())
+				errs[i] = status.Errorf(codes.Internal, "%v", err)
+			}
+		}
+	}
+
+	return errs, nil
+}
+
 // GetTicket gets the Ticket with the specified id from state storage. This method fails if the Ticket does not exist.
 func (rb *redisBackend) GetTicket(ctx context.Context, id string) (*pb.Ticket, error) {
 	redisConn, err := rb.redisPool.GetContext(ctx)
@@ -99,6 +294,16 @@ func (rb *redisBackend) DeleteTicket(ctx context.Context, id string) error {
 	}
 	defer handleConnectionClose(&redisConn)
 
+	err = redisConn.Send("HDEL", ticketGenerations, id)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to delete the ticket generation, id: %s", id)
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+	err = rb.sendTicketTombstone(redisConn, id, pb.Ticket_DELETED, 0)
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
 	value, err := redis.Int(redisConn.Do("DEL", id))
 	if err != nil {
 		err = errors.Wrapf(err, "failed to delete the ticket from state storage, id: %s", id)
@@ -112,6 +317,185 @@ func (rb *redisBackend) DeleteTicket(ctx context.Context, id string) error {
 	return nil
 }
 
+// DeleteTickets removes multiple Tickets with the specified ids from state storage in a single pipeline.
+// Tickets which do not exist are ignored.
+func (rb *redisBackend) DeleteTickets(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "DeleteTickets, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	args := make([]interface{}, 0, len(ids)+1)
+	args = append(args, ticketGenerations)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	err = redisConn.Send("HDEL", args...)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to delete the ticket generations, ids: %v", ids)
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+	for _, id := range ids {
+		err = rb.sendTicketTombstone(redisConn, id, pb.Ticket_DELETED, 0)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+	}
+
+	_, err = redisConn.Do("DEL", args[1:]...)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to delete the tickets from state storage, ids: %v", ids)
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return nil
+}
+
+// UpdateTicket replaces the SearchFields and Extensions of the stored Ticket with the ones of the input Ticket,
+// and increments its generation, in a single transaction. The Ticket keeps its id and create time.
+// This method fails if the Ticket does not exist, is not indexed, is pending release or is assigned.
+func (rb *redisBackend) UpdateTicket(ctx context.Context, ticket *pb.Ticket) (*pb.Ticket, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "UpdateTicket, id: %s, failed to connect to redis: %v", ticket.GetId(), err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	var updated *pb.Ticket
+	backoffOperation := func() error {
+		updated, err = rb.tryUpdateTicket(redisConn, ticket)
+		if err == errUpdateConflict {
+			return err
+		}
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		return nil
+	}
+
+	err = backoff.Retry(backoffOperation, rb.newExponentialBackoffStrategy())
+	if err == errUpdateConflict {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// updateTicketScript sets the ticket, if it is still indexed and not pending release, along with its generation.
+// It returns 0 if the ticket was set, 1 if it is not indexed and 2 if it is pending release.
+// KEYS: ticket id, allTickets, proposedTicketIDs, ticketGenerations
+// ARGV: ticket value, pending release cutoff in nanoseconds, generation
+var updateTicketScript = redis.NewScript(4, `
+if redis.call('SISMEMBER', KEYS[2], KEYS[1]) == 0 then
+  return 1
+end
+local score = redis.call('ZSCORE', KEYS[3], KEYS[1])
+if score and tonumber(score) >= tonumber(ARGV[2]) then
+  return 2
+end
+redis.call('SET', KEYS[1], ARGV[1], 'XX')
+redis.call('HSET', KEYS[4], KEYS[1], ARGV[3])
+return 0
+`)
+
+// tryUpdateTicket makes a single compare-and-set attempt at updating the ticket. Only the ticket is watched, so
+// errUpdateConflict is returned if the ticket was assigned, deleted or updated during the attempt. The index and
+// the pending release set change all the time, so they are checked by updateTicketScript along with the set.
+func (rb *redisBackend) tryUpdateTicket(redisConn redis.Conn, ticket *pb.Ticket) (*pb.Ticket, error) {
+	id := ticket.GetId()
+	_, err := redisConn.Do("WATCH", id)
+	if err != nil {
+		return nil, errors.Wrap(err, "error watching ticket")
+	}
+
+	value, err := redis.Bytes(redisConn.Do("GET", id))
+	if err == redis.ErrNil {
+		return nil, status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get the ticket from state storage, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	stored := &pb.Ticket{}
+	err = proto.Unmarshal(value, stored)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to unmarshal the ticket proto, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	if stored.GetAssignment() != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Ticket id: %s is assigned", id)
+	}
+
+	stored.SearchFields = ticket.GetSearchFields()
+	stored.Extensions = ticket.GetExtensions()
+	stored.Generation++
+
+	value, err = proto.Marshal(stored)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to marshal the ticket proto, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		return nil, errors.Wrap(err, "error starting redis multi")
+	}
+	// Same as in GetIndexedIDSet, scores of tickets with a custom or extended timeout may be in the future.
+	cutoff := time.Now().Add(-rb.cfg.GetDuration("pendingReleaseTimeout")).UnixNano()
+	err = updateTicketScript.Send(redisConn, id, allTickets, proposedTicketIDs, ticketGenerations, value, cutoff, stored.Generation)
+	if err != nil {
+		return nil, errors.Wrap(err, "error sending ticket update script")
+	}
+
+	replies, err := redis.Values(redisConn.Do("EXEC"))
+	if err == redis.ErrNil {
+		return nil, errUpdateConflict
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error executing ticket update")
+	}
+	result, err := redis.Int(replies[0], nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error executing ticket update script")
+	}
+
+	switch result {
+	case 1:
+		// Deleted tickets are deindexed right away, but only deleted lazily.
+		return nil, status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+	case 2:
+		return nil, status.Errorf(codes.FailedPrecondition, "Ticket id: %s is pending release", id)
+	}
+	return stored, nil
+}
+
+// GetTicketGenerations returns the generations of all tickets which were updated. Generations are removed
+// when tickets are deindexed, assigned or deleted, so that the hash does not grow with expired tickets.
+func (rb *redisBackend) GetTicketGenerations(ctx context.Context) (map[string]int64, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "GetTicketGenerations, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	generations, err := redis.Int64Map(redisConn.Do("HGETALL", ticketGenerations))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting ticket generations %v", err)
+	}
+
+	return generations, nil
+}
+
 // IndexTicket indexes the Ticket id for the configured index fields.
 func (rb *redisBackend) IndexTicket(ctx context.Context, ticket *pb.Ticket) error {
 	redisConn, err := rb.redisPool.GetContext(ctx)
@@ -142,10 +526,74 @@ func (rb *redisBackend) DeindexTicket(ctx context.Context, id string) error {
 		err = errors.Wrapf(err, "failed to remove ticket from all tickets, id: %s", id)
 		return status.Errorf(codes.Internal, "%v", err)
 	}
+	// Tickets which are not indexed can't be updated anymore, so their generations aren't needed.
+	err = redisConn.Send("HDEL", ticketGenerations, id)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to delete the ticket generation, id: %s", id)
+		return status.Errorf(codes.Internal, "%v", err)
+	}
 
 	return nil
 }
 
+// DeindexTickets removes the indexing for multiple Tickets in a single pipeline. Only the indexes are removed
+// but the Tickets continue to exist. The returned errors are in the order of ids, and nil for the Tickets
+// which were deindexed.
+func (rb *redisBackend) DeindexTickets(ctx context.Context, ids []string) ([]error, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "DeindexTickets, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	for _, id := range ids {
+		err = redisConn.Send("SREM", allTickets, id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket index remove"))
+		}
+	}
+	// Sent after the index removals, so that their replies come first.
+	if len(ids) != 0 {
+		generationArgs := make([]interface{}, 0, len(ids)+1)
+		generationArgs = append(generationArgs, ticketGenerations)
+		for _, id := range ids {
+			generationArgs = append(generationArgs, id)
+		}
+		err = redisConn.Send("HDEL", generationArgs...)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending ticket generation delete"))
+		}
+	}
+
+	err = redisConn.Flush()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to flush the ticket pipeline"))
+	}
+
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		_, err = redisConn.Receive()
+		if err == nil {
+			continue
+		}
+		if _, ok := err.(redis.Error); !ok {
+			err = errors.Wrapf(err, "failed to deindex tickets")
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		err = errors.Wrapf(err, "failed to remove ticket from all tickets, id: %s", id)
+		errs[i] = status.Errorf(codes.Internal, "%v", err)
+	}
+	if len(ids) != 0 {
+		_, err = redisConn.Receive()
+		if err != nil {
+			err = errors.Wrap(err, "failed to delete the ticket generations")
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+	}
+
+	return errs, nil
+}
+
 // GetIndexedIds returns the ids of all tickets currently indexed.
 func (rb *redisBackend) GetIndexedIDSet(ctx context.Context) (map[string]struct{}, error) {
 	redisConn, err := rb.redisPool.GetContext(ctx)
@@ -156,11 +604,11 @@ func (rb *redisBackend) GetIndexedIDSet(ctx context.Context) (map[string]struct{
 
 	ttl := rb.cfg.GetDuration("pendingReleaseTimeout")
 	curTime := time.Now()
-	endTimeInt := curTime.Add(time.Hour).UnixNano()
 	startTimeInt := curTime.Add(-ttl).UnixNano()
 
 	// Filter out tickets that are fetched but not assigned within ttl time (ms).
-	idsInPendingReleases, err := redis.Strings(redisConn.Do("ZRANGEBYSCORE", proposedTicketIDs, startTimeInt, endTimeInt))
+	// Scores of tickets with a custom or extended timeout may be in the future.
+	idsInPendingReleases, err := redis.Strings(redisConn.Do("ZRANGEBYSCORE", proposedTicketIDs, startTimeInt, "+inf"))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error getting pending release %v", err)
 	}
@@ -181,6 +629,21 @@ func (rb *redisBackend) GetIndexedIDSet(ctx context.Context) (map[string]struct{
 	return r, nil
 }
 
+// CountIndexedTickets returns the number of tickets currently indexed, including pending ones.
+func (rb *redisBackend) CountIndexedTickets(ctx context.Context) (int, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Unavailable, "CountIndexedTickets, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	count, err := redis.Int(redisConn.Do("SCARD", allTickets))
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "error counting indexed tickets %v", err)
+	}
+	return count, nil
+}
+
 // GetTickets returns multiple tickets from storage.  Missing tickets are
 // silently ignored.
 func (rb *redisBackend) GetTickets(ctx context.Context, ids []string) ([]*pb.Ticket, error) {
@@ -224,21 +687,15 @@ func (rb *redisBackend) GetTickets(ctx context.Context, ids []string) ([]*pb.Tic
 }
 
 // UpdateAssignments update using the request's specified tickets with assignments.
+// Tickets which already have an assignment are only updated if the request sets overwrite.
+// The parties of assigned tickets expire along with them.
 func (rb *redisBackend) UpdateAssignments(ctx context.Context, req *pb.AssignTicketsRequest) (*pb.AssignTicketsResponse, []*pb.Ticket, error) {
-	resp := &pb.AssignTicketsResponse{}
 	if len(req.Assignments) == 0 {
-		return resp, []*pb.Ticket{}, nil
+		return &pb.AssignTicketsResponse{}, []*pb.Ticket{}, nil
 	}
 
-	redisConn, err := rb.redisPool.GetContext(ctx)
-	if err != nil {
-		return nil, nil, status.Errorf(codes.Unavailable, "UpdateAssignments, failed to connect to redis: %v", err)
-	}
-	defer handleConnectionClose(&redisConn)
-
 	idToA := make(map[string]*pb.Assignment)
 	ids := make([]string, 0)
-	idsI := make([]interface{}, 0)
 	for _, a := range req.Assignments {
 		if a.Assignment == nil {
 			return nil, nil, status.Error(codes.InvalidArgument, "AssignmentGroup.Assignment is required")
@@ -251,7 +708,72 @@ func (rb *redisBackend) UpdateAssignments(ctx context.Context, req *pb.AssignTic
 
 			idToA[id] = a.Assignment
 			ids = append(ids, id)
-			idsI = append(idsI, id)
+		}
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Unavailable, "UpdateAssignments, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	var resp *pb.AssignTicketsResponse
+	var assignedTickets []*pb.Ticket
+	backoffOperation := func() error {
+		resp, assignedTickets, err = rb.tryUpdateAssignments(redisConn, req, ids, idToA)
+		if err == errAssignmentConflict {
+			return err
+		}
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		return nil
+	}
+
+	err = backoff.Retry(backoffOperation, rb.newExponentialBackoffStrategy())
+	if err == errAssignmentConflict {
+		return nil, nil, status.Error(codes.Aborted, err.Error())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, assignedTickets, nil
+}
+
+// tryUpdateAssignments makes a single compare-and-set attempt at assigning the tickets. The tickets and the
+// idempotency key are watched, so errAssignmentConflict is returned if any of them changed during the attempt.
+func (rb *redisBackend) tryUpdateAssignments(redisConn redis.Conn, req *pb.AssignTicketsRequest, ids []string, idToA map[string]*pb.Assignment) (*pb.AssignTicketsResponse, []*pb.Ticket, error) {
+	idsI := make([]interface{}, 0, len(ids)+1)
+	for _, id := range ids {
+		idsI = append(idsI, id)
+	}
+
+	key := req.GetIdempotencyKey()
+	if key != "" {
+		_, err := redisConn.Do("WATCH", append(idsI, assignmentIdempotencyKey(key))...)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error watching tickets")
+		}
+
+		value, err := redis.Bytes(redisConn.Do("GET", assignmentIdempotencyKey(key)))
+		if err == nil {
+			// The request was already applied, return the original result.
+			resp := &pb.AssignTicketsResponse{}
+			err = proto.Unmarshal(value, resp)
+			if err != nil {
+				err = errors.Wrapf(err, "failed to unmarshal assign tickets response, idempotency key: %s", key)
+				return nil, nil, status.Errorf(codes.Internal, "%v", err)
+			}
+			return resp, []*pb.Ticket{}, nil
+		}
+		if err != redis.ErrNil {
+			return nil, nil, errors.Wrap(err, "error getting idempotency key")
+		}
+	} else {
+		_, err := redisConn.Do("WATCH", idsI...)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error watching tickets")
 		}
 	}
 
@@ -260,6 +782,7 @@ func (rb *redisBackend) UpdateAssignments(ctx context.Context, req *pb.AssignTic
 		return nil, nil, err
 	}
 
+	resp := &pb.AssignTicketsResponse{}
 	tickets := make([]*pb.Ticket, 0, len(ticketBytes))
 	for i, ticketByte := range ticketBytes {
 		// Tickets may be deleted by the time we read it from redis.
@@ -268,16 +791,26 @@ func (rb *redisBackend) UpdateAssignments(ctx context.Context, req *pb.AssignTic
 				TicketId: ids[i],
 				Cause:    pb.AssignmentFailure_TICKET_NOT_FOUND,
 			})
-		} else {
-			t := &pb.Ticket{}
-			err = proto.Unmarshal(ticketByte, t)
-			if err != nil {
-				err = errors.Wrapf(err, "failed to unmarshal ticket from redis %s", ids[i])
-				return nil, nil, status.Errorf(codes.Internal, "%v", err)
-			}
-			tickets = append(tickets, t)
+			continue
+		}
+
+		t := &pb.Ticket{}
+		err = proto.Unmarshal(ticketByte, t)
+		if err != nil {
+			err = errors.Wrapf(err, "failed to unmarshal ticket from redis %s", ids[i])
+			return nil, nil, status.Errorf(codes.Internal, "%v", err)
+		}
+
+		if t.Assignment != nil && !req.GetOverwrite() {
+			resp.Failures = append(resp.Failures, &pb.AssignmentFailure{
+				TicketId: ids[i],
+				Cause:    pb.AssignmentFailure_ALREADY_ASSIGNED,
+			})
+			continue
 		}
+		tickets = append(tickets, t)
 	}
+
 	assignmentTimeout := rb.cfg.GetDuration("assignedDeleteTimeout") / time.Millisecond
 	err = redisConn.Send("MULTI")
 	if err != nil {
@@ -299,35 +832,77 @@ func (rb *redisBackend) UpdateAssignments(ctx context.Context, req *pb.AssignTic
 		}
 	}
 
+	// Sent after the tickets, so that the replies of the ticket sets come first.
+	for _, ticket := range tickets {
+		err = rb.sendTicketTombstone(redisConn, ticket.Id, pb.Ticket_EXPIRED, rb.cfg.GetDuration("assignedDeleteTimeout"))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Assigned tickets expire and can't be updated anymore, so their generations aren't needed.
+	if len(tickets) != 0 {
+		generationArgs := make([]interface{}, 0, len(tickets)+1)
+		generationArgs = append(generationArgs, ticketGenerations)
+		for _, ticket := range tickets {
+			generationArgs = append(generationArgs, ticket.Id)
+		}
+		err = redisConn.Send("HDEL", generationArgs...)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error sending ticket generation delete")
+		}
+	}
+
+	// A party expires along with its assigned member tickets.
+	parties := map[string]bool{}
+	for _, ticket := range tickets {
+		if ticket.GetPartyId() == "" || parties[ticket.GetPartyId()] {
+			continue
+		}
+		parties[ticket.GetPartyId()] = true
+		err = redisConn.Send("PEXPIRE", partyKey(ticket.GetPartyId()), int64(assignmentTimeout))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error sending party expiry")
+		}
+	}
+
+	if key != "" {
+		var respByte []byte
+		respByte, err = proto.Marshal(resp)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to marshal assign tickets response, idempotency key: %s", key)
+		}
+
+		err = redisConn.Send("SET", assignmentIdempotencyKey(key), respByte, "PX", int64(assignmentTimeout))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error sending idempotency key set")
+		}
+	}
+
 	wasSet, err := redis.Values(redisConn.Do("EXEC"))
+	if err == redis.ErrNil {
+		return nil, nil, errAssignmentConflict
+	}
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "error executing assignment set")
 	}
 
-	if len(wasSet) != len(tickets) {
+	// Tickets are watched, so they cannot be deleted between reading and setting them.
+	if len(wasSet) < len(tickets) {
 		return nil, nil, status.Errorf(codes.Internal, "sent %d tickets to redis, but received %d back", len(tickets), len(wasSet))
 	}
 
-	assignedTickets := make([]*pb.Ticket, 0, len(tickets))
 	for i, ticket := range tickets {
 		v, err := redis.String(wasSet[i], nil)
-		if err == redis.ErrNil {
-			resp.Failures = append(resp.Failures, &pb.AssignmentFailure{
-				TicketId: ticket.Id,
-				Cause:    pb.AssignmentFailure_TICKET_NOT_FOUND,
-			})
-			continue
-		}
 		if err != nil {
-			return nil, nil, errors.Wrap(err, "unexpected error from redis multi set")
+			return nil, nil, errors.Wrapf(err, "unexpected error from redis multi set, ticket: %s", ticket.Id)
 		}
 		if v != "OK" {
 			return nil, nil, status.Errorf(codes.Internal, "unexpected response from redis: %s", v)
 		}
-		assignedTickets = append(assignedTickets, ticket)
 	}
 
-	return resp, assignedTickets, nil
+	return resp, tickets, nil
 }
 
 // GetAssignments returns the assignment associated with the input ticket id
@@ -360,8 +935,9 @@ func (rb *redisBackend) GetAssignments(ctx context.Context, id string, callback
 	return nil
 }
 
-// AddTicketsToPendingRelease appends new proposed tickets to the proposed sorted set with current timestamp
-func (rb *redisBackend) AddTicketsToPendingRelease(ctx context.Context, ids []string) error {
+// AddTicketsToPendingRelease appends new proposed tickets to the proposed sorted set with current timestamp.
+// If timeout is non zero, the tickets are released after timeout instead of the configured pendingReleaseTimeout.
+func (rb *redisBackend) AddTicketsToPendingRelease(ctx context.Context, ids []string, timeout time.Duration) error {
 	if len(ids) == 0 {
 		return nil
 	}
@@ -372,11 +948,11 @@ func (rb *redisBackend) AddTicketsToPendingRelease(ctx context.Context, ids []st
 	}
 	defer handleConnectionClose(&redisConn)
 
-	currentTime := time.Now().UnixNano()
+	score := rb.pendingReleaseScore(time.Now(), timeout)
 	cmds := make([]interface{}, 0, 2*len(ids)+1)
 	cmds = append(cmds, proposedTicketIDs)
 	for _, id := range ids {
-		cmds = append(cmds, currentTime, id)
+		cmds = append(cmds, score, id)
 	}
 
 	_, err = redisConn.Do("ZADD", cmds...)
@@ -388,6 +964,65 @@ func (rb *redisBackend) AddTicketsToPendingRelease(ctx context.Context, ids []st
 	return nil
 }
 
+// extendPendingReleaseScript postpones the release of the tickets which are still pending, and returns the
+// ids of the tickets which are not pending anymore. Released tickets are not revived, as ZADD XX only updates
+// tickets in the set, and tickets past their release are not updated.
+// KEYS: proposedTicketIDs
+// ARGV: score of tickets released before now, new score, ticket ids...
+var extendPendingReleaseScript = redis.NewScript(1, `
+local releasedBefore = tonumber(ARGV[1])
+local newScore = tonumber(ARGV[2])
+local notPending = {}
+for i = 3, #ARGV do
+  local score = redis.call('ZSCORE', KEYS[1], ARGV[i])
+  if not score or tonumber(score) < releasedBefore then
+    table.insert(notPending, ARGV[i])
+  elseif newScore > tonumber(score) then
+    redis.call('ZADD', KEYS[1], 'XX', ARGV[2], ARGV[i])
+  end
+end
+return notPending
+`)
+
+// ExtendPendingRelease postpones the release of the pending tickets to timeout from now, or the configured
+// pendingReleaseTimeout if timeout is zero. Tickets are never released earlier than previously set.
+// Returns the ids of the tickets which are not pending anymore, and thus were not extended.
+func (rb *redisBackend) ExtendPendingRelease(ctx context.Context, ids []string, timeout time.Duration) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "ExtendPendingRelease, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	now := time.Now()
+	args := make([]interface{}, 0, len(ids)+3)
+	args = append(args, proposedTicketIDs, now.Add(-rb.cfg.GetDuration("pendingReleaseTimeout")).UnixNano(), rb.pendingReleaseScore(now, timeout))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	notPending, err := redis.Strings(extendPendingReleaseScript.Do(redisConn, args...))
+	if err != nil {
+		err = errors.Wrap(err, "failed to extend pending release of tickets")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return notPending, nil
+}
+
+// pendingReleaseScore returns the score of tickets added to the pending release at the given time. Tickets are
+// released once their score is older than the configured pendingReleaseTimeout, so a custom timeout is applied
+// by shifting the score by its difference to the configured one.
+func (rb *redisBackend) pendingReleaseScore(now time.Time, timeout time.Duration) int64 {
+	if timeout <= 0 {
+		return now.UnixNano()
+	}
+	return now.Add(timeout - rb.cfg.GetDuration("pendingReleaseTimeout")).UnixNano()
+}
+
 // DeleteTicketsFromPendingRelease deletes tickets from the proposed sorted set
 func (rb *redisBackend) DeleteTicketsFromPendingRelease(ctx context.Context, ids []string) error {
 	if len(ids) == 0 {
@@ -432,7 +1067,6 @@ func (rb *redisBackend) newConstantBackoffStrategy() backoff.BackOff {
 }
 
 // TODO: add cache the backoff object
-// nolint: unused
 func (rb *redisBackend) newExponentialBackoffStrategy() backoff.BackOff {
 	backoffStrat := backoff.NewExponentialBackOff()
 	backoffStrat.InitialInterval = rb.cfg.GetDuration("backoff.initialInterval")
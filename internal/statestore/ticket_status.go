@@ -0,0 +1,229 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/golang/protobuf/proto"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// A tombstone records whether a Ticket which is not in state storage anymore was deleted or has expired.
+func ticketTombstoneKey(id string) string {
+	return "ticket_tombstone/" + id
+}
+
+// getTicketTombstoneTimeout returns how long tombstones are kept, which defaults to the assignedDeleteTimeout.
+func getTicketTombstoneTimeout(cfg config.View) time.Duration {
+	if ttl := cfg.GetDuration("ticketTombstoneTimeout"); ttl > 0 {
+		return ttl
+	}
+	return cfg.GetDuration("assignedDeleteTimeout")
+}
+
+// sendTicketTombstone queues setting the tombstone of a Ticket, which is kept for ttl after the tombstone timeout.
+func (rb *redisBackend) sendTicketTombstone(redisConn redis.Conn, id string, s pb.Ticket_Status, ttl time.Duration) error {
+	ttl += getTicketTombstoneTimeout(rb.cfg)
+	err := redisConn.Send("SET", ticketTombstoneKey(id), int32(s), "PX", int64(ttl/time.Millisecond))
+	if err != nil {
+		return errors.Wrapf(err, "error sending ticket tombstone set, id: %s", id)
+	}
+	return nil
+}
+
+// GetTicketWithStatus gets the Ticket with the specified id from state storage, and sets its Status from the
+// Assignment, the index and the pending release set, in a single transaction.
+// Tickets which were recently deleted or have expired are returned with only their id and Status set.
+// This method fails if the Ticket does not exist.
+func (rb *redisBackend) GetTicketWithStatus(ctx context.Context, id string) (*pb.Ticket, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "GetTicketWithStatus, id: %s, failed to connect to redis: %v", id, err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	replies, err := rb.execTicketStatusQueries(redisConn, []string{id})
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get the ticket status, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	ticket, err := rb.ticketWithStatus(id, replies)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil {
+		return nil, status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+	}
+	return ticket, nil
+}
+
+// GetTicketsWithStatus gets multiple Tickets from state storage, with their Status set like GetTicketWithStatus,
+// in a single transaction. Tickets which do not exist are skipped.
+func (rb *redisBackend) GetTicketsWithStatus(ctx context.Context, ids []string) ([]*pb.Ticket, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "GetTicketsWithStatus, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	replies, err := rb.execTicketStatusQueries(redisConn, ids)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get the ticket statuses %v", ids)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	r := make([]*pb.Ticket, 0, len(ids))
+	for i, id := range ids {
+		ticket, err := rb.ticketWithStatus(id, replies[i*ticketStatusQueries:(i+1)*ticketStatusQueries])
+		if err != nil {
+			return nil, err
+		}
+		if ticket != nil {
+			r = append(r, ticket)
+		}
+	}
+	return r, nil
+}
+
+// ticketStatusQueries is the number of replies execTicketStatusQueries returns per Ticket.
+const ticketStatusQueries = 4
+
+// execTicketStatusQueries reads everything the Status of the Tickets is derived from in a single transaction.
+func (rb *redisBackend) execTicketStatusQueries(redisConn redis.Conn, ids []string) ([]interface{}, error) {
+	err := redisConn.Send("MULTI")
+	if err != nil {
+		return nil, errors.Wrap(err, "error starting redis multi")
+	}
+	for _, id := range ids {
+		for _, cmd := range [][]interface{}{
+			{"GET", id},
+			{"SISMEMBER", allTickets, id},
+			{"ZSCORE", proposedTicketIDs, id},
+			{"GET", ticketTombstoneKey(id)},
+		} {
+			err = redisConn.Send(cmd[0].(string), cmd[1:]...)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error sending %s", cmd[0])
+			}
+		}
+	}
+
+	replies, err := redis.Values(redisConn.Do("EXEC"))
+	if err != nil {
+		return nil, err
+	}
+	if len(replies) != ticketStatusQueries*len(ids) {
+		return nil, errors.Errorf("expected %d replies from redis, received %d", ticketStatusQueries*len(ids), len(replies))
+	}
+	return replies, nil
+}
+
+// ticketWithStatus builds the Ticket with its Status set from the replies of execTicketStatusQueries.
+// It returns nil if the Ticket does not exist.
+func (rb *redisBackend) ticketWithStatus(id string, replies []interface{}) (*pb.Ticket, error) {
+	if replies[0] == nil {
+		tombstone, err := redis.Int(replies[3], nil)
+		if err == redis.ErrNil {
+			return nil, nil
+		}
+		if err != nil {
+			err = errors.Wrapf(err, "failed to read the ticket tombstone, id: %s", id)
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		return &pb.Ticket{Id: id, Status: pb.Ticket_Status(tombstone)}, nil
+	}
+
+	value, err := redis.Bytes(replies[0], nil)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to get the ticket from state storage, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	ticket := &pb.Ticket{}
+	err = proto.Unmarshal(value, ticket)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to unmarshal the ticket proto, id: %s", id)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	indexed, err := redis.Bool(replies[1], nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error checking the ticket index %v", err)
+	}
+	// Same as in GetIndexedIDSet, scores of tickets with a custom or extended timeout may be in the future.
+	score, err := redis.Float64(replies[2], nil)
+	if err != nil && err != redis.ErrNil {
+		return nil, status.Errorf(codes.Internal, "error getting pending release %v", err)
+	}
+	pending := err == nil && int64(score) >= time.Now().Add(-rb.cfg.GetDuration("pendingReleaseTimeout")).UnixNano()
+
+	switch {
+	case ticket.GetAssignment() != nil:
+		ticket.Status = pb.Ticket_ASSIGNED
+	case !indexed:
+		// Deleted tickets are deindexed right away, but only deleted lazily.
+		ticket.Status = pb.Ticket_DELETED
+	case pending:
+		ticket.Status = pb.Ticket_PENDING
+	default:
+		ticket.Status = pb.Ticket_SEARCHING
+	}
+
+	return ticket, nil
+}
+
+// WatchTicket polls the Ticket with the specified id, and calls the callback with the Ticket whenever its
+// Status changes. It returns after the callback was called with a DELETED or EXPIRED Ticket.
+// This method fails if the Ticket does not exist.
+func (rb *redisBackend) WatchTicket(ctx context.Context, id string, callback func(*pb.Ticket) error) error {
+	last := pb.Ticket_UNKNOWN
+	backoffOperation := func() error {
+		if ctx.Err() != nil {
+			return backoff.Permanent(status.Errorf(codes.Aborted, ctx.Err().Error()))
+		}
+
+		ticket, err := rb.GetTicketWithStatus(ctx, id)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		if ticket.Status != last {
+			last = ticket.Status
+			err = callback(ticket)
+			if err != nil {
+				return backoff.Permanent(err)
+			}
+		}
+
+		if last == pb.Ticket_DELETED || last == pb.Ticket_EXPIRED {
+			return nil
+		}
+		return status.Error(codes.Unavailable, "listening on ticket status updates, waiting for the next backoff")
+	}
+
+	return backoff.Retry(backoffOperation, rb.newConstantBackoffStrategy())
+}
@@ -0,0 +1,171 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestGetTicketWithStatus(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
+	require.NoError(t, err)
+	defer c.Close()
+
+	requireStatus := func(id string, want pb.Ticket_Status) *pb.Ticket {
+		ticket, err := service.GetTicketWithStatus(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, id, ticket.Id)
+		require.Equal(t, want, ticket.Status)
+		return ticket
+	}
+
+	ids := createSearchingTickets(ctx, t, service, 2)
+
+	ticket := requireStatus(ids[0], pb.Ticket_SEARCHING)
+	require.NotNil(t, ticket.SearchFields)
+
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, ids[:1], 0))
+	requireStatus(ids[0], pb.Ticket_PENDING)
+
+	_, _, err = service.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{TicketIds: ids[:1], Assignment: &pb.Assignment{Connection: "a"}}},
+	})
+	require.NoError(t, err)
+	requireStatus(ids[0], pb.Ticket_ASSIGNED)
+
+	// Simulate the expiration of the assigned ticket.
+	_, err = c.Do("DEL", ids[0])
+	require.NoError(t, err)
+	ticket = requireStatus(ids[0], pb.Ticket_EXPIRED)
+	require.Nil(t, ticket.SearchFields)
+
+	require.NoError(t, service.DeindexTicket(ctx, ids[1]))
+	ticket = requireStatus(ids[1], pb.Ticket_DELETED)
+	require.NotNil(t, ticket.SearchFields)
+
+	require.NoError(t, service.DeleteTicket(ctx, ids[1]))
+	ticket = requireStatus(ids[1], pb.Ticket_DELETED)
+	require.Nil(t, ticket.SearchFields)
+
+	_, err = service.GetTicketWithStatus(ctx, "missing")
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+
+	// pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	_, err = service.GetTicketWithStatus(ctx, ids[0])
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "GetTicketWithStatus, id: searching-0, failed to connect to redis:")
+}
+
+func TestGetTicketsWithStatus(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	ids := createSearchingTickets(ctx, t, service, 3)
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, ids[1:2], 0))
+	require.NoError(t, service.DeindexTicket(ctx, ids[2]))
+	require.NoError(t, service.DeleteTicket(ctx, ids[2]))
+
+	tickets, err := service.GetTicketsWithStatus(ctx, []string{ids[2], "missing", ids[1], ids[0]})
+	require.NoError(t, err)
+	require.Len(t, tickets, 3)
+	require.Equal(t, ids[2], tickets[0].Id)
+	require.Equal(t, pb.Ticket_DELETED, tickets[0].Status)
+	require.Equal(t, ids[1], tickets[1].Id)
+	require.Equal(t, pb.Ticket_PENDING, tickets[1].Status)
+	require.Equal(t, ids[0], tickets[2].Id)
+	require.Equal(t, pb.Ticket_SEARCHING, tickets[2].Status)
+	require.NotNil(t, tickets[2].SearchFields)
+
+	tickets, err = service.GetTicketsWithStatus(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, tickets)
+}
+
+func TestWatchTicket(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	ids := createSearchingTickets(ctx, t, service, 1)
+
+	statuses := make(chan pb.Ticket_Status)
+	done := make(chan error)
+	go func() {
+		done <- service.WatchTicket(ctx, ids[0], func(ticket *pb.Ticket) error {
+			statuses <- ticket.Status
+			return nil
+		})
+	}()
+
+	require.Equal(t, pb.Ticket_SEARCHING, <-statuses)
+
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, ids, 0))
+	require.Equal(t, pb.Ticket_PENDING, <-statuses)
+
+	require.NoError(t, service.DeleteTicketsFromPendingRelease(ctx, ids))
+	require.Equal(t, pb.Ticket_SEARCHING, <-statuses)
+
+	require.NoError(t, service.DeindexTicket(ctx, ids[0]))
+	require.NoError(t, service.DeleteTicket(ctx, ids[0]))
+	require.Equal(t, pb.Ticket_DELETED, <-statuses)
+	require.NoError(t, <-done)
+
+	err := service.WatchTicket(ctx, "missing", func(*pb.Ticket) error {
+		require.Fail(t, "unexpected callback")
+		return nil
+	})
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+}
+
+// createSearchingTickets creates and indexes tickets without an assignment.
+func createSearchingTickets(ctx context.Context, t *testing.T, service Service, amount int) []string {
+	ids := make([]string, 0, amount)
+	for i := 0; i < amount; i++ {
+		ticket := &pb.Ticket{
+			Id:           fmt.Sprintf("searching-%d", i),
+			SearchFields: &pb.SearchFields{Tags: []string{"tag"}},
+		}
+		require.NoError(t, service.CreateTicket(ctx, ticket))
+		require.NoError(t, service.IndexTicket(ctx, ticket))
+		ids = append(ids, ticket.Id)
+	}
+	return ids
+}
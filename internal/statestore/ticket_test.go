@@ -25,6 +25,8 @@ import (
 
 	"github.com/Bose/minisentinel"
 	miniredis "github.com/alicebob/miniredis/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/gomodule/redigo/redis"
 	"github.com/rs/xid"
 	"github.com/spf13/viper"
@@ -212,7 +214,29 @@ func TestUpdateAssignments(t *testing.T) {
 			},
 		},
 		{
-			description: "updated assignments, no errors",
+			description: "ticket is already assigned, response failure expected",
+			request: &pb.AssignTicketsRequest{
+				Assignments: []*pb.AssignmentGroup{
+					{
+						TicketIds:  []string{"1"},
+						Assignment: &pb.Assignment{Connection: "3"},
+					},
+				},
+			},
+			expected: expected{
+				resp: &pb.AssignTicketsResponse{
+					Failures: []*pb.AssignmentFailure{{
+						TicketId: "1",
+						Cause:    pb.AssignmentFailure_ALREADY_ASSIGNED,
+					}},
+				},
+				errCode:            codes.OK,
+				errMessage:         "",
+				assignedTicketsIDs: []string{},
+			},
+		},
+		{
+			description: "updated assignments with overwrite, no errors",
 			request: &pb.AssignTicketsRequest{
 				Assignments: []*pb.AssignmentGroup{
 					{
@@ -220,6 +244,7 @@ func TestUpdateAssignments(t *testing.T) {
 						Assignment: &pb.Assignment{Connection: "2"},
 					},
 				},
+				Overwrite: true,
 			},
 			expected: expected{
 				resp:               &pb.AssignTicketsResponse{},
@@ -351,6 +376,48 @@ func TestUpdateAssignments(t *testing.T) {
 	require.Contains(t, status.Convert(err).Message(), "UpdateAssignments, failed to connect to redis: context canceled")
 }
 
+func TestUpdateAssignmentsIdempotencyKey(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	for _, id := range []string{"1", "2"} {
+		err := service.CreateTicket(ctx, &pb.Ticket{Id: id})
+		require.NoError(t, err)
+	}
+
+	req := &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{"1", "missing"},
+				Assignment: &pb.Assignment{Connection: "a"},
+			},
+		},
+		IdempotencyKey: "key",
+	}
+	resp, assigned, err := service.UpdateAssignments(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, assigned, 1)
+	require.Len(t, resp.Failures, 1)
+
+	// A retry returns the original result, rather than failing as already assigned.
+	retryResp, assigned, err := service.UpdateAssignments(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, assigned, 0)
+	require.True(t, proto.Equal(resp, retryResp))
+
+	// The key is not applied again, even if the request differs.
+	req.Assignments[0].TicketIds = []string{"2"}
+	_, _, err = service.UpdateAssignments(ctx, req)
+	require.NoError(t, err)
+	ticket, err := service.GetTicket(ctx, "2")
+	require.NoError(t, err)
+	require.Nil(t, ticket.Assignment)
+}
+
 func TestConnect(t *testing.T) {
 	testConnect(t, false, "")
 	testConnect(t, false, "redispassword")
@@ -582,6 +649,161 @@ func TestDeleteTicket(t *testing.T) {
 	require.Contains(t, status.Convert(err).Message(), "DeleteTicket, id: 12345, failed to connect to redis:")
 }
 
+func TestUpdateTicket(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	createTime := ptypes.TimestampNow()
+	for _, id := range []string{"searching", "pending", "assigned", "deindexed"} {
+		ticket := &pb.Ticket{
+			Id:           id,
+			CreateTime:   createTime,
+			SearchFields: &pb.SearchFields{StringArgs: map[string]string{"map": "old"}},
+		}
+		require.NoError(t, service.CreateTicket(ctx, ticket))
+		require.NoError(t, service.IndexTicket(ctx, ticket))
+	}
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, []string{"pending"}, 0))
+	_, _, err := service.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{TicketIds: []string{"assigned"}, Assignment: &pb.Assignment{Connection: "a"}}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, service.DeindexTicket(ctx, "deindexed"))
+
+	update := func(id string) (*pb.Ticket, error) {
+		return service.UpdateTicket(ctx, &pb.Ticket{
+			Id:           id,
+			SearchFields: &pb.SearchFields{StringArgs: map[string]string{"map": "new"}},
+		})
+	}
+
+	updated, err := update("searching")
+	require.NoError(t, err)
+	require.Equal(t, "new", updated.SearchFields.StringArgs["map"])
+	require.True(t, proto.Equal(createTime, updated.CreateTime))
+	require.Equal(t, int64(1), updated.Generation)
+
+	stored, err := service.GetTicket(ctx, "searching")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(updated, stored))
+
+	updated, err = update("searching")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated.Generation)
+
+	generations, err := service.GetTicketGenerations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"searching": 2}, generations)
+
+	for id, code := range map[string]codes.Code{
+		"pending":   codes.FailedPrecondition,
+		"assigned":  codes.FailedPrecondition,
+		"deindexed": codes.NotFound,
+		"missing":   codes.NotFound,
+	} {
+		_, err = update(id)
+		require.Equal(t, code.String(), status.Convert(err).Code().String(), id)
+	}
+
+	require.NoError(t, service.DeleteTicket(ctx, "searching"))
+	generations, err = service.GetTicketGenerations(ctx)
+	require.NoError(t, err)
+	require.Empty(t, generations)
+
+	// Generations are also removed when tickets are assigned, and expire later, or are deindexed.
+	for _, id := range []string{"toAssign", "toDeindex", "toBatchDeindex"} {
+		ticket := &pb.Ticket{Id: id}
+		require.NoError(t, service.CreateTicket(ctx, ticket))
+		require.NoError(t, service.IndexTicket(ctx, ticket))
+		_, err = update(id)
+		require.NoError(t, err)
+	}
+	generations, err = service.GetTicketGenerations(ctx)
+	require.NoError(t, err)
+	require.Len(t, generations, 3)
+
+	_, _, err = service.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{TicketIds: []string{"toAssign"}, Assignment: &pb.Assignment{Connection: "a"}}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, service.DeindexTicket(ctx, "toDeindex"))
+	errs, err := service.DeindexTickets(ctx, []string{"toBatchDeindex"})
+	require.NoError(t, err)
+	require.Equal(t, []error{nil}, errs)
+
+	generations, err = service.GetTicketGenerations(ctx)
+	require.NoError(t, err)
+	require.Empty(t, generations)
+}
+
+// multiHookConn runs beforeMulti once, right before a transaction is started on the connection.
+type multiHookConn struct {
+	redis.Conn
+	beforeMulti func()
+}
+
+func (c *multiHookConn) Send(cmd string, args ...interface{}) error {
+	if cmd == "MULTI" && c.beforeMulti != nil {
+		c.beforeMulti()
+		c.beforeMulti = nil
+	}
+	return c.Conn.Send(cmd, args...)
+}
+
+func TestUpdateTicketProposedConcurrently(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	ticket := &pb.Ticket{Id: "1"}
+	require.NoError(t, service.CreateTicket(ctx, ticket))
+	require.NoError(t, service.IndexTicket(ctx, ticket))
+
+	rb, ok := service.(*instrumentedService).s.(*redisBackend)
+	require.True(t, ok)
+	conn, err := rb.redisPool.GetContext(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// The ticket is proposed after it was read, but before it is set.
+	hooked := &multiHookConn{Conn: conn, beforeMulti: func() {
+		require.NoError(t, service.AddTicketsToPendingRelease(ctx, []string{"1"}, 0))
+	}}
+	_, err = rb.tryUpdateTicket(hooked, &pb.Ticket{Id: "1", SearchFields: &pb.SearchFields{Tags: []string{"new"}}})
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+
+	stored, err := service.GetTicket(ctx, "1")
+	require.NoError(t, err)
+	require.Nil(t, stored.SearchFields)
+
+	// Proposals and releases of other tickets do not conflict with the update.
+	require.NoError(t, service.DeleteTicketsFromPendingRelease(ctx, []string{"1"}))
+	hooked = &multiHookConn{Conn: conn, beforeMulti: func() {
+		require.NoError(t, service.AddTicketsToPendingRelease(ctx, []string{"2"}, 0))
+	}}
+	updated, err := rb.tryUpdateTicket(hooked, &pb.Ticket{Id: "1", SearchFields: &pb.SearchFields{Tags: []string{"new"}}})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), updated.Generation)
+
+	stored, err = service.GetTicket(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"new"}, stored.SearchFields.Tags)
+
+	// The ticket is deindexed after it was read, but before it is set.
+	hooked = &multiHookConn{Conn: conn, beforeMulti: func() {
+		require.NoError(t, service.DeindexTicket(ctx, "1"))
+	}}
+	_, err = rb.tryUpdateTicket(hooked, &pb.Ticket{Id: "1"})
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+}
+
 func TestIndexTicket(t *testing.T) {
 	cfg, closer := createRedis(t, false, "")
 	defer closer()
@@ -696,6 +918,35 @@ func TestGetIndexedIDSet(t *testing.T) {
 	require.Contains(t, status.Convert(err).Message(), "GetIndexedIDSet, failed to connect to redis:")
 }
 
+func TestCountIndexedTickets(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	count, err := service.CountIndexedTickets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	_, ids := generateTickets(ctx, t, service, 3)
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, ids[:1], 0))
+	require.NoError(t, service.DeindexTicket(ctx, ids[1]))
+
+	count, err = service.CountIndexedTickets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// Pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	_, err = service.CountIndexedTickets(ctx)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "CountIndexedTickets, failed to connect to redis:")
+}
+
 func TestGetTickets(t *testing.T) {
 	cfg, closer := createRedis(t, false, "")
 	defer closer()
@@ -729,6 +980,118 @@ func TestGetTickets(t *testing.T) {
 	require.Contains(t, status.Convert(err).Message(), "GetTickets, failed to connect to redis:")
 }
 
+func TestCreateTickets(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	tickets := []*pb.Ticket{{Id: "1"}, {Id: "2"}}
+	errs, err := service.CreateTickets(ctx, tickets)
+	require.NoError(t, err)
+	require.Equal(t, []error{nil, nil}, errs)
+
+	for _, ticket := range tickets {
+		res, err := service.GetTicket(ctx, ticket.Id)
+		require.NoError(t, err)
+		require.Equal(t, ticket.Id, res.Id)
+	}
+	ids, err := service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"1": {}, "2": {}}, ids)
+
+	// pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	_, err = service.CreateTickets(ctx, tickets)
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "CreateTickets, failed to connect to redis:")
+}
+
+func TestCreateTicketIdempotent(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	created, err := service.CreateTicketIdempotent(ctx, &pb.Ticket{Id: "1", Owner: "alice"}, "key")
+	require.NoError(t, err)
+	require.Equal(t, "1", created.Id)
+
+	// A retry returns the current state of the original Ticket, rather than creating another one.
+	_, err = service.UpdateTicket(ctx, &pb.Ticket{Id: "1", SearchFields: &pb.SearchFields{Tags: []string{"new"}}})
+	require.NoError(t, err)
+	created, err = service.CreateTicketIdempotent(ctx, &pb.Ticket{Id: "2", Owner: "alice"}, "key")
+	require.NoError(t, err)
+	require.Equal(t, "1", created.Id)
+	require.Equal(t, []string{"new"}, created.SearchFields.Tags)
+	require.Equal(t, int64(1), created.Generation)
+	_, err = service.GetTicket(ctx, "2")
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Keys are scoped to the owner.
+	created, err = service.CreateTicketIdempotent(ctx, &pb.Ticket{Id: "3", Owner: "bob"}, "key")
+	require.NoError(t, err)
+	require.Equal(t, "3", created.Id)
+
+	ids, err := service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"1": {}, "3": {}}, ids)
+
+	// Retries after the original Ticket was deleted do not find it.
+	require.NoError(t, service.DeindexTicket(ctx, "3"))
+	require.NoError(t, service.DeleteTicket(ctx, "3"))
+	_, err = service.CreateTicketIdempotent(ctx, &pb.Ticket{Id: "4", Owner: "bob"}, "key")
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = service.GetTicket(ctx, "4")
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDeindexAndDeleteTickets(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	_, ids := generateTickets(ctx, t, service, 3)
+
+	// Tickets which do not exist are deindexed and deleted without errors.
+	errs, err := service.DeindexTickets(ctx, []string{ids[0], ids[1], "missing"})
+	require.NoError(t, err)
+	require.Equal(t, []error{nil, nil, nil}, errs)
+	indexed, err := service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{ids[2]: {}}, indexed)
+
+	err = service.DeleteTickets(ctx, []string{ids[0], ids[1], "missing"})
+	require.NoError(t, err)
+	tickets, err := service.GetTickets(ctx, ids)
+	require.NoError(t, err)
+	require.Len(t, tickets, 1)
+	require.Equal(t, ids[2], tickets[0].Id)
+
+	require.NoError(t, service.DeleteTickets(ctx, nil))
+
+	// pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	_, err = service.DeindexTickets(ctx, ids)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "DeindexTickets, failed to connect to redis:")
+	err = service.DeleteTickets(ctx, ids)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "DeleteTickets, failed to connect to redis:")
+}
+
 func TestDeleteTicketsFromPendingRelease(t *testing.T) {
 	cfg, closer := createRedis(t, false, "")
 	defer closer()
@@ -852,25 +1215,98 @@ func TestAddTicketsToPendingRelease(t *testing.T) {
 	verifyTickets(service, tickets)
 
 	// Add 1st ticket to pending release state
-	require.NoError(t, service.AddTicketsToPendingRelease(ctx, ids[:1]))
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, ids[:1], 0))
 
 	// Verify 1 ticket is indexed
 	verifyTickets(service, tickets[1:2])
 
 	// Pass an empty ids slice
 	empty := []string{}
-	require.NoError(t, service.AddTicketsToPendingRelease(ctx, empty))
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, empty, 0))
 
 	// Pass an expired context, err expected
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 	service = New(cfg)
-	err := service.AddTicketsToPendingRelease(ctx, ids)
+	err := service.AddTicketsToPendingRelease(ctx, ids, 0)
 	require.Error(t, err)
 	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
 	require.Contains(t, status.Convert(err).Message(), "AddTicketsToPendingRelease, failed to connect to redis:")
 }
 
+func TestExtendPendingRelease(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	_, ids := generateTickets(ctx, t, service, 4)
+
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, ids[:2], 0))
+	// A custom timeout outlasts the configured pendingReleaseTimeout of 200ms.
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, ids[3:], time.Second))
+
+	notPending, err := service.ExtendPendingRelease(ctx, []string{ids[0], ids[2]}, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []string{ids[2]}, notPending)
+
+	time.Sleep(300 * time.Millisecond)
+
+	indexed, err := service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, indexed, 2)
+	require.Contains(t, indexed, ids[1])
+	require.Contains(t, indexed, ids[2])
+
+	// Released tickets cannot be extended anymore.
+	notPending, err = service.ExtendPendingRelease(ctx, []string{ids[0], ids[1]}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{ids[1]}, notPending)
+
+	// Pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	_, err = service.ExtendPendingRelease(ctx, ids, 0)
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "ExtendPendingRelease, failed to connect to redis:")
+}
+
+func TestExtendPendingReleaseReleased(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
+	require.NoError(t, err)
+	defer c.Close()
+
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, []string{"1", "2", "3"}, 0))
+	// Ticket 2 was released, and ticket 3 is past its release but was not removed from the set yet.
+	require.NoError(t, service.DeleteTicketsFromPendingRelease(ctx, []string{"2"}))
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, []string{"3"}, time.Millisecond))
+	time.Sleep(10 * time.Millisecond)
+	before, err := redis.Float64(c.Do("ZSCORE", proposedTicketIDs, "3"))
+	require.NoError(t, err)
+
+	notPending, err := service.ExtendPendingRelease(ctx, []string{"1", "2", "3"}, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2", "3"}, notPending)
+
+	// Released tickets are not revived.
+	_, err = redis.Float64(c.Do("ZSCORE", proposedTicketIDs, "2"))
+	require.Equal(t, redis.ErrNil, err)
+	after, err := redis.Float64(c.Do("ZSCORE", proposedTicketIDs, "3"))
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+}
+
 func testConnect(t *testing.T, withSentinel bool, withPassword string) {
 	cfg, closer := createRedis(t, withSentinel, withPassword)
 	defer closer()
@@ -915,6 +1351,7 @@ func createRedis(t *testing.T, withSentinel bool, withPassword string) (config.V
 	cfg.Set("backoff.maxElapsedTime", 100*time.Millisecond)
 	cfg.Set(telemetry.ConfigNameEnableMetrics, true)
 	cfg.Set("assignedDeleteTimeout", 1000*time.Millisecond)
+	cfg.Set("matchRecordTimeout", 1000*time.Millisecond)
 
 	if withSentinel {
 		s := minisentinel.NewSentinel(mredis)
@@ -0,0 +1,111 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WaitTime is the time to assignment of a Ticket, recorded in the bucket of its search fields.
+type WaitTime struct {
+	Bucket   string
+	TicketID string
+	Wait     time.Duration
+}
+
+func waitTimesKey(bucket string) string {
+	return "wait_times/" + bucket
+}
+
+// AddWaitTimes records the times to assignment in their buckets. Each bucket is a sorted set scored by the
+// time of the assignment, which keeps the maxSamples most recent ones within the window.
+func (rb *redisBackend) AddWaitTimes(ctx context.Context, waits []WaitTime, maxSamples int, window time.Duration) error {
+	if len(waits) == 0 {
+		return nil
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "AddWaitTimes, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	now := time.Now()
+	buckets := map[string]struct{}{}
+	for _, w := range waits {
+		member := fmt.Sprintf("%d/%s", w.Wait.Milliseconds(), w.TicketID)
+		err = redisConn.Send("ZADD", waitTimesKey(w.Bucket), now.UnixNano(), member)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending wait time add"))
+		}
+		buckets[w.Bucket] = struct{}{}
+	}
+	for bucket := range buckets {
+		key := waitTimesKey(bucket)
+		err = redisConn.Send("ZREMRANGEBYRANK", key, 0, -maxSamples-1)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending wait time trim"))
+		}
+		err = redisConn.Send("ZREMRANGEBYSCORE", key, "-inf", now.Add(-window).UnixNano())
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending wait time trim"))
+		}
+		err = redisConn.Send("PEXPIRE", key, window.Milliseconds())
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error sending wait time expire"))
+		}
+	}
+
+	_, err = redisConn.Do("")
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to add wait times"))
+	}
+
+	return nil
+}
+
+// GetWaitTimes returns the times to assignment recorded in the bucket within the window.
+func (rb *redisBackend) GetWaitTimes(ctx context.Context, bucket string, window time.Duration) ([]time.Duration, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "GetWaitTimes, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	members, err := redis.Strings(redisConn.Do("ZRANGEBYSCORE", waitTimesKey(bucket), time.Now().Add(-window).UnixNano(), "+inf"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to get wait times"))
+	}
+
+	waits := make([]time.Duration, 0, len(members))
+	for _, m := range members {
+		ms, err := strconv.ParseInt(strings.SplitN(m, "/", 2)[0], 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "failed to parse wait time %s", m))
+		}
+		waits = append(waits, time.Duration(ms)*time.Millisecond)
+	}
+
+	return waits, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+)
+
+func TestWaitTimes(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	require.NoError(t, service.AddWaitTimes(ctx, nil, 3, time.Hour))
+
+	waits := []WaitTime{}
+	for i := 1; i <= 4; i++ {
+		waits = append(waits, WaitTime{Bucket: "a", TicketID: fmt.Sprintf("a-%d", i), Wait: time.Duration(i) * time.Second})
+	}
+	waits = append(waits, WaitTime{Bucket: "b", TicketID: "b-1", Wait: 1500 * time.Millisecond})
+	require.NoError(t, service.AddWaitTimes(ctx, waits, 3, time.Hour))
+
+	// Samples added together have the same score, so the trim is by member.
+	got, err := service.GetWaitTimes(ctx, "a", time.Hour)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+
+	got, err = service.GetWaitTimes(ctx, "b", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{1500 * time.Millisecond}, got)
+
+	require.NoError(t, service.AddWaitTimes(ctx, []WaitTime{{Bucket: "a", TicketID: "a-5", Wait: 5 * time.Second}}, 3, time.Hour))
+	got, err = service.GetWaitTimes(ctx, "a", time.Hour)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	require.Contains(t, got, 5*time.Second)
+
+	got, err = service.GetWaitTimes(ctx, "missing", time.Hour)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	// pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	err = service.AddWaitTimes(ctx, waits, 3, time.Hour)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	_, err = service.GetWaitTimes(ctx, "a", time.Hour)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+}
@@ -174,6 +174,12 @@ pendingReleaseTimeout: 1s
 assignedDeleteTimeout: 200ms
 queryPageSize: 10
 backfillLockTimeout: 1m
+matchRecordTimeout: 10m
+ticketTombstoneTimeout: 10s
+
+waitEstimates:
+  enabled: true
+  stringArgs: [wait_group]
 
 logging:
   level: debug
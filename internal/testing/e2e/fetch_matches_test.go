@@ -77,6 +77,150 @@ func TestHappyPath(t *testing.T) {
 	require.Nil(t, resp)
 }
 
+// TestFetchMatchesDryRun covers that matches from a dry run are returned as non
+// binding, and that their tickets are not moved to pending release.
+func TestFetchMatchesDryRun(t *testing.T) {
+	ctx := context.Background()
+	om := newOM(t)
+
+	t1, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.Nil(t, err)
+
+	m := &pb.Match{
+		MatchId: "1",
+		Tickets: []*pb.Ticket{t1},
+	}
+
+	om.SetMMF(func(ctx context.Context, profile *pb.MatchProfile, out chan<- *pb.Match) error {
+		out <- m
+		return nil
+	})
+
+	om.SetEvaluator(func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+		for p := range in {
+			out <- p.MatchId
+		}
+		return nil
+	})
+
+	stream, err := om.Backend().FetchMatches(ctx, &pb.FetchMatchesRequest{
+		Config:  om.MMFConfigGRPC(),
+		Profile: &pb.MatchProfile{},
+		DryRun:  true,
+	})
+	require.Nil(t, err)
+
+	resp, err := stream.Recv()
+	require.Nil(t, err)
+	require.True(t, proto.Equal(m, resp.Match))
+	require.True(t, resp.NonBinding)
+
+	resp, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+	require.Nil(t, resp)
+
+	// The ticket is still available for other matches.
+	qs, err := om.Query().QueryTickets(ctx, &pb.QueryTicketsRequest{Pool: &pb.Pool{}})
+	require.Nil(t, err)
+
+	qresp, err := qs.Recv()
+	require.Nil(t, err)
+	require.Len(t, qresp.Tickets, 1)
+	require.Equal(t, t1.Id, qresp.Tickets[0].Id)
+}
+
+// TestMatchRecords covers that matches returned by FetchMatches can be read
+// back, are updated with their assignment, and can be released by match id.
+func TestMatchRecords(t *testing.T) {
+	ctx := context.Background()
+	om := newOM(t)
+
+	t1, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.Nil(t, err)
+	t2, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.Nil(t, err)
+
+	om.SetMMF(func(ctx context.Context, profile *pb.MatchProfile, out chan<- *pb.Match) error {
+		out <- &pb.Match{
+			MatchId:       "record-1",
+			MatchProfile:  profile.Name,
+			MatchFunction: "mmf",
+			Tickets:       []*pb.Ticket{t1},
+		}
+		out <- &pb.Match{
+			MatchId:       "record-2",
+			MatchProfile:  profile.Name,
+			MatchFunction: "mmf",
+			Tickets:       []*pb.Ticket{t2},
+		}
+		return nil
+	})
+
+	om.SetEvaluator(func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+		for p := range in {
+			out <- p.MatchId
+		}
+		return nil
+	})
+
+	stream, err := om.Backend().FetchMatches(ctx, &pb.FetchMatchesRequest{
+		Config:  om.MMFConfigGRPC(),
+		Profile: &pb.MatchProfile{Name: "match-records"},
+	})
+	require.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = stream.Recv()
+		require.Nil(t, err)
+	}
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+
+	record, err := om.Backend().GetMatch(ctx, &pb.GetMatchRequest{MatchId: "record-1"})
+	require.Nil(t, err)
+	require.Equal(t, "match-records", record.MatchProfile)
+	require.Equal(t, "mmf", record.MatchFunction)
+	require.Equal(t, []string{t1.Id}, record.TicketIds)
+	require.NotNil(t, record.CreateTime)
+
+	_, err = om.Backend().GetMatch(ctx, &pb.GetMatchRequest{MatchId: "unknown"})
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+
+	list, err := om.Backend().ListMatches(ctx, &pb.ListMatchesRequest{MatchProfile: "match-records"})
+	require.Nil(t, err)
+	require.Len(t, list.Matches, 2)
+
+	list, err = om.Backend().ListMatches(ctx, &pb.ListMatchesRequest{MatchProfile: "other"})
+	require.Nil(t, err)
+	require.Len(t, list.Matches, 0)
+
+	_, err = om.Backend().AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{t1.Id},
+				Assignment: &pb.Assignment{Connection: "a"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	record, err = om.Backend().GetMatch(ctx, &pb.GetMatchRequest{MatchId: "record-1"})
+	require.Nil(t, err)
+	require.Equal(t, "a", record.Assignment.GetConnection())
+
+	_, err = om.Backend().ReleaseMatch(ctx, &pb.ReleaseMatchRequest{MatchId: "record-2"})
+	require.Nil(t, err)
+
+	// The released ticket is available again, while the assigned one is not.
+	qs, err := om.Query().QueryTickets(ctx, &pb.QueryTicketsRequest{Pool: &pb.Pool{}})
+	require.Nil(t, err)
+
+	qresp, err := qs.Recv()
+	require.Nil(t, err)
+	require.Len(t, qresp.Tickets, 1)
+	require.Equal(t, t2.Id, qresp.Tickets[0].Id)
+}
+
 // TestMatchFunctionMatchCollision covers two matches with the same id coming
 // from the same MMF generates an error to the fetch matches call.  Also ensures
 // another function running in the same cycle does not experience an error.
@@ -219,6 +363,77 @@ func TestSynchronizerMatchCollision(t *testing.T) {
 	require.Nil(t, resp)
 }
 
+// TestPartitionedCycles covers profiles of different synchronization
+// partitions being synchronized in concurrent cycles, and matches with the same
+// ticket from concurrent partitions being returned only once.
+func TestPartitionedCycles(t *testing.T) {
+	ctx := context.Background()
+	om := newOM(t)
+
+	t1, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.Nil(t, err)
+
+	slowCalled := make(chan struct{})
+	quickCalled := make(chan struct{})
+	om.SetMMF(func(ctx context.Context, profile *pb.MatchProfile, out chan<- *pb.Match) error {
+		if profile.Name == "slow" {
+			close(slowCalled)
+			// Only finishes if the quick profile's mmf runs while this one is
+			// running, which requires its cycle to run concurrently.
+			select {
+			case <-quickCalled:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		} else {
+			close(quickCalled)
+		}
+		out <- &pb.Match{
+			MatchId:      profile.Name,
+			MatchProfile: profile.Name,
+			Tickets:      []*pb.Ticket{t1},
+		}
+		return nil
+	})
+
+	om.SetEvaluator(func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+		for m := range in {
+			out <- m.MatchId
+		}
+		return nil
+	})
+
+	matches := make(chan int, 2)
+	fetch := func(name string) {
+		stream, err := om.Backend().FetchMatches(ctx, &pb.FetchMatchesRequest{
+			Config: om.MMFConfigGRPC(),
+			Profile: &pb.MatchProfile{
+				Name:                     name,
+				SynchronizationPartition: name,
+			},
+		})
+		require.Nil(t, err)
+
+		count := 0
+		for {
+			_, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.Nil(t, err)
+			count++
+		}
+		matches <- count
+	}
+
+	go fetch("slow")
+	<-slowCalled
+	go fetch("quick")
+
+	// The ticket is only in one of the matches returned.
+	require.Equal(t, 1, <-matches+<-matches)
+}
+
 // TestEvaluatorReturnInvalidId covers the evaluator returning an ID which does
 // not correspond to any match passed to it.
 func TestEvaluatorReturnInvalidId(t *testing.T) {
@@ -176,6 +176,70 @@ func TestAssignTicketsMissingTicket(t *testing.T) {
 	require.True(t, proto.Equal(expected, resp), fmt.Sprintf("Protobuf messages are not equal\nexpected: %v\nactual: %v", expected, resp))
 }
 
+// TestAssignTicketsAlreadyAssigned covers that assigning a ticket a second time
+// fails unless overwrite is set, and that retries sharing an idempotency key
+// return the original result.
+func TestAssignTicketsAlreadyAssigned(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	t1, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.Nil(t, err)
+
+	req := &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{t1.Id},
+				Assignment: &pb.Assignment{Connection: "a"},
+			},
+		},
+		IdempotencyKey: "first",
+	}
+
+	resp, err := om.Backend().AssignTickets(ctx, req)
+	require.Nil(t, err)
+	require.Len(t, resp.Failures, 0)
+
+	// Retrying with the same key returns the original result.
+	resp, err = om.Backend().AssignTickets(ctx, req)
+	require.Nil(t, err)
+	require.Len(t, resp.Failures, 0)
+
+	req = &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{t1.Id},
+				Assignment: &pb.Assignment{Connection: "b"},
+			},
+		},
+	}
+
+	resp, err = om.Backend().AssignTickets(ctx, req)
+	require.Nil(t, err)
+	expected := &pb.AssignTicketsResponse{
+		Failures: []*pb.AssignmentFailure{
+			{
+				TicketId: t1.Id,
+				Cause:    pb.AssignmentFailure_ALREADY_ASSIGNED,
+			},
+		},
+	}
+	require.True(t, proto.Equal(expected, resp), fmt.Sprintf("Protobuf messages are not equal\nexpected: %v\nactual: %v", expected, resp))
+
+	get, err := om.Frontend().GetTicket(ctx, &pb.GetTicketRequest{TicketId: t1.Id})
+	require.Nil(t, err)
+	require.Equal(t, "a", get.Assignment.Connection)
+
+	req.Overwrite = true
+	resp, err = om.Backend().AssignTickets(ctx, req)
+	require.Nil(t, err)
+	require.Len(t, resp.Failures, 0)
+
+	get, err = om.Frontend().GetTicket(ctx, &pb.GetTicketRequest{TicketId: t1.Id})
+	require.Nil(t, err)
+	require.Equal(t, "b", get.Assignment.Connection)
+}
+
 func TestTicketDelete(t *testing.T) {
 	om := newOM(t)
 	ctx := context.Background()
@@ -491,6 +555,103 @@ func TestTicketReleaseByTimeout(t *testing.T) {
 	}
 }
 
+// TestExtendPendingRelease covers extending the pending release of the tickets
+// of a match beyond the configured timeout, and of a profile's tickets using a
+// custom timeout.
+func TestExtendPendingRelease(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		profile *pb.MatchProfile
+		extend  bool
+	}{
+		{
+			name:    "extended by match id",
+			profile: &pb.MatchProfile{Name: "test-profile"},
+			extend:  true,
+		},
+		{
+			name: "profile timeout",
+			profile: &pb.MatchProfile{
+				Name:                  "test-profile",
+				PendingReleaseTimeout: ptypes.DurationProto(pendingReleaseTimeout * 3),
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			om := newOM(t)
+			ctx := context.Background()
+
+			ticket, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+			require.Nil(t, err)
+
+			om.SetMMF(func(ctx context.Context, profile *pb.MatchProfile, out chan<- *pb.Match) error {
+				out <- &pb.Match{
+					MatchId: "1",
+					Tickets: []*pb.Ticket{ticket},
+				}
+				return nil
+			})
+			om.SetEvaluator(func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+				for p := range in {
+					out <- p.MatchId
+				}
+				return nil
+			})
+
+			stream, err := om.Backend().FetchMatches(ctx, &pb.FetchMatchesRequest{
+				Config:  om.MMFConfigGRPC(),
+				Profile: tt.profile,
+			})
+			require.Nil(t, err)
+
+			_, err = stream.Recv()
+			require.Nil(t, err)
+			_, err = stream.Recv()
+			require.Equal(t, io.EOF, err)
+
+			if tt.extend {
+				resp, err := om.Backend().ExtendPendingRelease(ctx, &pb.ExtendPendingReleaseRequest{
+					TicketIds: []string{"unknown"},
+					MatchId:   "1",
+					Timeout:   ptypes.DurationProto(pendingReleaseTimeout * 3),
+				})
+				require.Nil(t, err)
+				require.Equal(t, []string{"unknown"}, resp.NotPendingTicketIds)
+			}
+
+			time.Sleep(pendingReleaseTimeout * 3 / 2)
+
+			// Ticket is still pending, so NOT present in query
+			qs, err := om.Query().QueryTickets(ctx, &pb.QueryTicketsRequest{Pool: &pb.Pool{}})
+			require.Nil(t, err)
+
+			qresp, err := qs.Recv()
+			require.Equal(t, io.EOF, err)
+			require.Nil(t, qresp)
+		})
+	}
+}
+
+// TestExtendPendingReleaseInvalidArgument covers invalid calls to extend
+// pending release.
+func TestExtendPendingReleaseInvalidArgument(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	_, err := om.Backend().ExtendPendingRelease(ctx, &pb.ExtendPendingReleaseRequest{})
+	require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
+
+	_, err = om.Backend().ExtendPendingRelease(ctx, &pb.ExtendPendingReleaseRequest{
+		TicketIds: []string{"1"},
+		Timeout:   ptypes.DurationProto(-time.Second),
+	})
+	require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
+
+	_, err = om.Backend().ExtendPendingRelease(ctx, &pb.ExtendPendingReleaseRequest{MatchId: "unknown"})
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+}
+
 // TestCreateTicketErrors covers invalid arguments when calling create ticket.
 func TestCreateTicketErrors(t *testing.T) {
 	for _, tt := range []struct {
@@ -646,3 +807,306 @@ func TestWatchAssignments(t *testing.T) {
 		require.Equal(t, "a", a.Connection)
 	}
 }
+
+// TestParty covers creating a party, querying it whole, assigning it through
+// one of its members, and deleting it with its members.
+func TestParty(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	resp, err := om.Frontend().CreateParty(ctx, &pb.CreatePartyRequest{
+		Tickets: []*pb.Ticket{
+			{SearchFields: &pb.SearchFields{DoubleArgs: map[string]float64{"mmr": 10}}},
+			{SearchFields: &pb.SearchFields{DoubleArgs: map[string]float64{"mmr": 20}}},
+		},
+	})
+	require.Nil(t, err)
+	require.Len(t, resp.Tickets, 2)
+	require.Equal(t, []string{resp.Tickets[0].Id, resp.Tickets[1].Id}, resp.Party.TicketIds)
+	for _, ticket := range resp.Tickets {
+		require.Equal(t, resp.Party.Id, ticket.PartyId)
+		require.Equal(t, int32(2), ticket.PartySize)
+		require.NotNil(t, ticket.CreateTime)
+	}
+
+	party, err := om.Frontend().GetParty(ctx, &pb.GetPartyRequest{PartyId: resp.Party.Id})
+	require.Nil(t, err)
+	require.Equal(t, resp.Party.TicketIds, party.TicketIds)
+
+	query := func(pool *pb.Pool) []string {
+		stream, err := om.Query().QueryTicketIds(ctx, &pb.QueryTicketIdsRequest{Pool: pool})
+		require.Nil(t, err)
+		var ids []string
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return ids
+			}
+			require.Nil(t, err)
+			ids = append(ids, resp.Ids...)
+		}
+	}
+	lowMmr := []*pb.DoubleRangeFilter{{DoubleArg: "mmr", Min: 0, Max: 15}}
+	require.Equal(t, []string{resp.Tickets[0].Id}, query(&pb.Pool{DoubleRangeFilters: lowMmr}))
+	require.Empty(t, query(&pb.Pool{DoubleRangeFilters: lowMmr, WholeParties: true}))
+	require.ElementsMatch(t, resp.Party.TicketIds, query(&pb.Pool{WholeParties: true}))
+
+	_, err = om.Backend().AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{resp.Tickets[0].Id},
+				Assignment: &pb.Assignment{Connection: "a"},
+			},
+		},
+	})
+	require.Nil(t, err)
+	for _, id := range resp.Party.TicketIds {
+		get, err := om.Frontend().GetTicket(ctx, &pb.GetTicketRequest{TicketId: id})
+		require.Nil(t, err)
+		require.Equal(t, "a", get.Assignment.Connection)
+	}
+
+	_, err = om.Frontend().DeleteParty(ctx, &pb.DeletePartyRequest{PartyId: resp.Party.Id})
+	require.Nil(t, err)
+	for _, id := range resp.Party.TicketIds {
+		_, err = om.Frontend().GetTicket(ctx, &pb.GetTicketRequest{TicketId: id})
+		require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+	}
+	_, err = om.Frontend().GetParty(ctx, &pb.GetPartyRequest{PartyId: resp.Party.Id})
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+}
+
+// TestCreatePartyErrors covers invalid CreateParty calls.
+func TestCreatePartyErrors(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name string
+		req  *pb.CreatePartyRequest
+	}{
+		{"no tickets", &pb.CreatePartyRequest{}},
+		{"create time", &pb.CreatePartyRequest{Tickets: []*pb.Ticket{{CreateTime: ptypes.TimestampNow()}}}},
+		{"assignment", &pb.CreatePartyRequest{Tickets: []*pb.Ticket{{Assignment: &pb.Assignment{}}}}},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := om.Frontend().CreateParty(ctx, tt.req)
+			require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
+		})
+	}
+}
+
+// TestUpdateTicket covers updating a ticket, which must be reflected in pools
+// while the ticket keeps its create time, and the rejection of updates of
+// assigned tickets.
+func TestUpdateTicket(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	query := func(region string) []*pb.Ticket {
+		stream, err := om.Query().QueryTickets(ctx, &pb.QueryTicketsRequest{Pool: &pb.Pool{
+			StringEqualsFilters: []*pb.StringEqualsFilter{{StringArg: "region", Value: region}},
+		}})
+		require.Nil(t, err)
+		var tickets []*pb.Ticket
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return tickets
+			}
+			require.Nil(t, err)
+			tickets = append(tickets, resp.Tickets...)
+		}
+	}
+
+	created, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{
+		SearchFields: &pb.SearchFields{StringArgs: map[string]string{"region": "eu"}},
+	}})
+	require.Nil(t, err)
+	require.Len(t, query("eu"), 1)
+
+	updated, err := om.Frontend().UpdateTicket(ctx, &pb.UpdateTicketRequest{Ticket: &pb.Ticket{
+		Id:           created.Id,
+		SearchFields: &pb.SearchFields{StringArgs: map[string]string{"region": "us"}},
+	}})
+	require.Nil(t, err)
+	require.Equal(t, created.Id, updated.Id)
+	require.True(t, proto.Equal(created.CreateTime, updated.CreateTime))
+
+	require.Empty(t, query("eu"))
+	us := query("us")
+	require.Len(t, us, 1)
+	require.True(t, proto.Equal(updated, us[0]))
+
+	_, err = om.Backend().AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{created.Id},
+				Assignment: &pb.Assignment{Connection: "a"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	_, err = om.Frontend().UpdateTicket(ctx, &pb.UpdateTicketRequest{Ticket: &pb.Ticket{Id: created.Id}})
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+
+	_, err = om.Frontend().UpdateTicket(ctx, &pb.UpdateTicketRequest{Ticket: &pb.Ticket{}})
+	require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
+}
+
+// TestBatchTickets covers creating, getting and deleting tickets in batches,
+// with a status for each ticket.
+func TestBatchTickets(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	created, err := om.Frontend().BatchCreateTickets(ctx, &pb.BatchCreateTicketsRequest{
+		Tickets: []*pb.Ticket{
+			{SearchFields: &pb.SearchFields{Tags: []string{"batch"}}},
+			{CreateTime: ptypes.TimestampNow()},
+			{SearchFields: &pb.SearchFields{Tags: []string{"batch"}}},
+		},
+	})
+	require.Nil(t, err)
+	require.Len(t, created.Results, 3)
+	require.Equal(t, int32(codes.OK), created.Results[0].Status.Code)
+	require.Equal(t, int32(codes.InvalidArgument), created.Results[1].Status.Code)
+	require.Equal(t, int32(codes.OK), created.Results[2].Status.Code)
+	ids := []string{created.Results[0].TicketId, created.Results[2].TicketId}
+
+	stream, err := om.Query().QueryTicketIds(ctx, &pb.QueryTicketIdsRequest{Pool: &pb.Pool{
+		TagPresentFilters: []*pb.TagPresentFilter{{Tag: "batch"}},
+	}})
+	require.Nil(t, err)
+	resp, err := stream.Recv()
+	require.Nil(t, err)
+	require.ElementsMatch(t, ids, resp.Ids)
+
+	got, err := om.Frontend().BatchGetTickets(ctx, &pb.BatchGetTicketsRequest{TicketIds: []string{ids[0], "missing", ids[1]}})
+	require.Nil(t, err)
+	require.Len(t, got.Results, 3)
+	require.Equal(t, int32(codes.NotFound), got.Results[1].Status.Code)
+	for _, i := range []int{0, 2} {
+		require.Equal(t, pb.Ticket_SEARCHING, got.Results[i].Ticket.Status)
+		got.Results[i].Ticket.Status = pb.Ticket_UNKNOWN
+		require.True(t, proto.Equal(created.Results[i].Ticket, got.Results[i].Ticket))
+	}
+
+	deleted, err := om.Frontend().BatchDeleteTickets(ctx, &pb.BatchDeleteTicketsRequest{TicketIds: ids})
+	require.Nil(t, err)
+	require.Len(t, deleted.Results, 2)
+	for i, r := range deleted.Results {
+		require.Equal(t, ids[i], r.TicketId)
+		require.Equal(t, int32(codes.OK), r.Status.Code)
+	}
+
+	// Same as GetTicket, deleted tickets are not found right away.
+	got, err = om.Frontend().BatchGetTickets(ctx, &pb.BatchGetTicketsRequest{TicketIds: ids})
+	require.Nil(t, err)
+	require.Equal(t, int32(codes.NotFound), got.Results[0].Status.Code)
+	require.Equal(t, int32(codes.NotFound), got.Results[1].Status.Code)
+
+	_, err = om.Frontend().BatchDeleteTickets(ctx, &pb.BatchDeleteTicketsRequest{})
+	require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
+}
+
+// TestWatchTicket covers the status of tickets, and that watching a ticket
+// streams its status changes until it is deleted.
+func TestWatchTicket(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	created, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.Nil(t, err)
+	require.Equal(t, pb.Ticket_UNKNOWN, created.Status)
+
+	got, err := om.Frontend().GetTicket(ctx, &pb.GetTicketRequest{TicketId: created.Id})
+	require.Nil(t, err)
+	require.Equal(t, pb.Ticket_SEARCHING, got.Status)
+
+	stream, err := om.Frontend().WatchTicket(ctx, &pb.WatchTicketRequest{TicketId: created.Id})
+	require.Nil(t, err)
+	resp, err := stream.Recv()
+	require.Nil(t, err)
+	require.Equal(t, created.Id, resp.Ticket.Id)
+	require.Equal(t, pb.Ticket_SEARCHING, resp.Ticket.Status)
+
+	_, err = om.Backend().AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{created.Id},
+				Assignment: &pb.Assignment{Connection: "a"},
+			},
+		},
+	})
+	require.Nil(t, err)
+	resp, err = stream.Recv()
+	require.Nil(t, err)
+	require.Equal(t, pb.Ticket_ASSIGNED, resp.Ticket.Status)
+	require.Equal(t, "a", resp.Ticket.Assignment.Connection)
+
+	_, err = om.Frontend().DeleteTicket(ctx, &pb.DeleteTicketRequest{TicketId: created.Id})
+	require.Nil(t, err)
+	resp, err = stream.Recv()
+	require.Nil(t, err)
+	require.Equal(t, pb.Ticket_DELETED, resp.Ticket.Status)
+
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+
+	_, err = om.Frontend().GetTicket(ctx, &pb.GetTicketRequest{TicketId: created.Id})
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+
+	stream, err = om.Frontend().WatchTicket(ctx, &pb.WatchTicketRequest{TicketId: "missing"})
+	require.Nil(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+}
+
+// TestEstimateWait covers estimating the wait of tickets from the times to
+// assignment of tickets with the same search fields.
+func TestEstimateWait(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	sf := &pb.SearchFields{StringArgs: map[string]string{"wait_group": t.Name() + time.Now().String()}}
+
+	estimate, err := om.Frontend().EstimateWait(ctx, &pb.EstimateWaitRequest{SearchFields: sf})
+	require.Nil(t, err)
+	require.Equal(t, int32(0), estimate.SampleCount)
+	require.Nil(t, estimate.Wait)
+
+	assigned, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{SearchFields: sf}})
+	require.Nil(t, err)
+	require.Nil(t, assigned.WaitEstimate)
+
+	time.Sleep(time.Millisecond * 500)
+	_, err = om.Backend().AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{assigned.Id},
+				Assignment: &pb.Assignment{Connection: "a"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	estimate, err = om.Frontend().EstimateWait(ctx, &pb.EstimateWaitRequest{SearchFields: sf})
+	require.Nil(t, err)
+	require.Equal(t, int32(1), estimate.SampleCount)
+	require.GreaterOrEqual(t, estimate.Wait.AsDuration(), time.Millisecond*500)
+
+	searching, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{SearchFields: sf}})
+	require.Nil(t, err)
+	got, err := om.Frontend().GetTicket(ctx, &pb.GetTicketRequest{TicketId: searching.Id})
+	require.Nil(t, err)
+	require.Equal(t, int32(1), got.WaitEstimate.SampleCount)
+	require.Less(t, got.WaitEstimate.Wait.AsDuration(), estimate.Wait.AsDuration())
+
+	// Assigned tickets have no estimate.
+	got, err = om.Frontend().GetTicket(ctx, &pb.GetTicketRequest{TicketId: assigned.Id})
+	require.Nil(t, err)
+	require.Nil(t, got.WaitEstimate)
+}
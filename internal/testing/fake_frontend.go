@@ -49,6 +49,36 @@ func (s *FakeFrontend) GetTicket(ctx context.Context, req *pb.GetTicketRequest)
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
 
+// UpdateTicket replaces the search fields and extensions of a ticket.
+func (s *FakeFrontend) UpdateTicket(ctx context.Context, req *pb.UpdateTicketRequest) (*pb.Ticket, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+// BatchCreateTickets creates multiple tickets.
+func (s *FakeFrontend) BatchCreateTickets(ctx context.Context, req *pb.BatchCreateTicketsRequest) (*pb.BatchCreateTicketsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+// BatchGetTickets fetches multiple tickets.
+func (s *FakeFrontend) BatchGetTickets(ctx context.Context, req *pb.BatchGetTicketsRequest) (*pb.BatchGetTicketsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+// BatchDeleteTickets removes multiple tickets.
+func (s *FakeFrontend) BatchDeleteTickets(ctx context.Context, req *pb.BatchDeleteTicketsRequest) (*pb.BatchDeleteTicketsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+// EstimateWait estimates the wait of a new ticket.
+func (s *FakeFrontend) EstimateWait(ctx context.Context, req *pb.EstimateWaitRequest) (*pb.WaitEstimate, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+// WatchTicket streams the status changes of a ticket.
+func (s *FakeFrontend) WatchTicket(req *pb.WatchTicketRequest, stream pb.FrontendService_WatchTicketServer) error {
+	return status.Error(codes.Unimplemented, "not implemented")
+}
+
 // WatchAssignments streams matchmaking results from Open Match for the
 // provided Ticket id.
 func (s *FakeFrontend) WatchAssignments(req *pb.WatchAssignmentsRequest, stream pb.FrontendService_WatchAssignmentsServer) error {
@@ -80,3 +110,18 @@ func (s *FakeFrontend) GetBackfill(ctx context.Context, req *pb.GetBackfillReque
 func (s *FakeFrontend) UpdateBackfill(ctx context.Context, req *pb.UpdateBackfillRequest) (*pb.Backfill, error) {
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
+
+// CreateParty creates the member Tickets of a Party.
+func (s *FakeFrontend) CreateParty(ctx context.Context, req *pb.CreatePartyRequest) (*pb.CreatePartyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+// GetParty fetches a Party by its ID.
+func (s *FakeFrontend) GetParty(ctx context.Context, req *pb.GetPartyRequest) (*pb.Party, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+// DeleteParty deletes a Party and its member Tickets.
+func (s *FakeFrontend) DeleteParty(ctx context.Context, req *pb.DeletePartyRequest) (*empty.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
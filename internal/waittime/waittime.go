@@ -0,0 +1,188 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package waittime records the times to assignment of Tickets and estimates
+// the wait of Tickets from them, as configured under waitEstimates.
+package waittime
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/statestore"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	defaultMaxSamples = 100
+	defaultWindow     = time.Hour
+)
+
+// Estimator records times to assignment in buckets of Tickets with the same
+// values for the configured search fields, and estimates waits from the
+// recent times to assignment of a bucket.  A nil Estimator is disabled.
+type Estimator struct {
+	store       statestore.Service
+	stringArgs  []string
+	tags        []string
+	doubleArgs  []string
+	bucketSizes map[string]float64
+	maxSamples  int
+	window      time.Duration
+}
+
+// New creates an Estimator if waitEstimates.enabled is set, or returns nil.
+// Tickets are bucketed by the values of the waitEstimates.stringArgs, by
+// which of the waitEstimates.tags they have, and by the values of the
+// waitEstimates.doubleArgs, rounded down to a multiple of
+// waitEstimates.bucketSizes.<name> if set.  Each bucket keeps the
+// waitEstimates.maxSamples most recent times to assignment within the
+// waitEstimates.window.
+func New(cfg config.View, store statestore.Service) *Estimator {
+	if !cfg.GetBool("waitEstimates.enabled") {
+		return nil
+	}
+
+	e := &Estimator{
+		store:       store,
+		stringArgs:  cfg.GetStringSlice("waitEstimates.stringArgs"),
+		tags:        cfg.GetStringSlice("waitEstimates.tags"),
+		doubleArgs:  cfg.GetStringSlice("waitEstimates.doubleArgs"),
+		bucketSizes: map[string]float64{},
+		maxSamples:  cfg.GetInt("waitEstimates.maxSamples"),
+		window:      cfg.GetDuration("waitEstimates.window"),
+	}
+	for _, name := range e.doubleArgs {
+		if size := cfg.GetFloat64("waitEstimates.bucketSizes." + name); size > 0 {
+			e.bucketSizes[name] = size
+		}
+	}
+	if e.maxSamples <= 0 {
+		e.maxSamples = defaultMaxSamples
+	}
+	if e.window <= 0 {
+		e.window = defaultWindow
+	}
+	return e
+}
+
+// bucket returns the name of the bucket of the search fields.
+func (e *Estimator) bucket(sf *pb.SearchFields) string {
+	var b strings.Builder
+	for _, name := range e.stringArgs {
+		if v, ok := sf.GetStringArgs()[name]; ok {
+			fmt.Fprintf(&b, "s:%s=%s;", name, strconv.Quote(v))
+		} else {
+			fmt.Fprintf(&b, "s:%s;", name)
+		}
+	}
+	for _, tag := range e.tags {
+		for _, t := range sf.GetTags() {
+			if t == tag {
+				fmt.Fprintf(&b, "t:%s;", tag)
+				break
+			}
+		}
+	}
+	for _, name := range e.doubleArgs {
+		v, ok := sf.GetDoubleArgs()[name]
+		if !ok {
+			fmt.Fprintf(&b, "d:%s;", name)
+			continue
+		}
+		if size, ok := e.bucketSizes[name]; ok {
+			v = math.Floor(v/size) * size
+		}
+		fmt.Fprintf(&b, "d:%s=%s;", name, strconv.FormatFloat(v, 'g', -1, 64))
+	}
+	return b.String()
+}
+
+// Record records the times to assignment of the assigned Tickets, from their
+// create time until now.
+func (e *Estimator) Record(ctx context.Context, tickets []*pb.Ticket) error {
+	if e == nil {
+		return nil
+	}
+
+	now := time.Now()
+	waits := make([]statestore.WaitTime, 0, len(tickets))
+	for _, t := range tickets {
+		created, err := ptypes.Timestamp(t.GetCreateTime())
+		if err != nil {
+			continue
+		}
+		waits = append(waits, statestore.WaitTime{
+			Bucket:   e.bucket(t.GetSearchFields()),
+			TicketID: t.GetId(),
+			Wait:     now.Sub(created),
+		})
+	}
+	return e.store.AddWaitTimes(ctx, waits, e.maxSamples, e.window)
+}
+
+// Estimate estimates the wait of a new Ticket with the search fields.
+func (e *Estimator) Estimate(ctx context.Context, sf *pb.SearchFields) (*pb.WaitEstimate, error) {
+	waits, err := e.store.GetWaitTimes(ctx, e.bucket(sf), e.window)
+	if err != nil {
+		return nil, err
+	}
+	return estimate(waits, 0), nil
+}
+
+// EstimateTicket estimates the remaining wait of the Ticket, from the times
+// to assignment at least as long as the time it has already waited.
+func (e *Estimator) EstimateTicket(ctx context.Context, ticket *pb.Ticket) (*pb.WaitEstimate, error) {
+	var waited time.Duration
+	if created, err := ptypes.Timestamp(ticket.GetCreateTime()); err == nil {
+		waited = time.Since(created)
+	}
+
+	waits, err := e.store.GetWaitTimes(ctx, e.bucket(ticket.GetSearchFields()), e.window)
+	if err != nil {
+		return nil, err
+	}
+	return estimate(waits, waited), nil
+}
+
+// estimate returns the median of the waits at least as long as waited, minus
+// waited.
+func estimate(waits []time.Duration, waited time.Duration) *pb.WaitEstimate {
+	remaining := make([]time.Duration, 0, len(waits))
+	for _, w := range waits {
+		if w >= waited {
+			remaining = append(remaining, w-waited)
+		}
+	}
+
+	result := &pb.WaitEstimate{SampleCount: int32(len(remaining))}
+	if len(remaining) == 0 {
+		return result
+	}
+
+	sort.Slice(remaining, func(i, j int) bool { return remaining[i] < remaining[j] })
+	median := remaining[len(remaining)/2]
+	if len(remaining)%2 == 0 {
+		median = (remaining[len(remaining)/2-1] + median) / 2
+	}
+	result.Wait = ptypes.DurationProto(median)
+	return result
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package waittime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func newTestConfig() *viper.Viper {
+	cfg := viper.New()
+	cfg.Set("waitEstimates.enabled", true)
+	cfg.Set("waitEstimates.stringArgs", []string{"mode"})
+	cfg.Set("waitEstimates.tags", []string{"beta"})
+	cfg.Set("waitEstimates.doubleArgs", []string{"mmr", "level"})
+	cfg.Set("waitEstimates.bucketSizes.mmr", 100)
+	return cfg
+}
+
+func TestNewDisabled(t *testing.T) {
+	e := New(viper.New(), nil)
+	require.Nil(t, e)
+	require.NoError(t, e.Record(utilTesting.NewContext(t), []*pb.Ticket{{Id: "1"}}))
+}
+
+func TestBucket(t *testing.T) {
+	e := New(newTestConfig(), nil)
+	require.Equal(t, defaultMaxSamples, e.maxSamples)
+	require.Equal(t, defaultWindow, e.window)
+
+	require.Equal(t, "s:mode;d:mmr;d:level;", e.bucket(nil))
+	require.Equal(t, `s:mode="ranked";t:beta;d:mmr=1200;d:level=3.5;`, e.bucket(&pb.SearchFields{
+		StringArgs: map[string]string{"mode": "ranked", "region": "eu"},
+		Tags:       []string{"other", "beta"},
+		DoubleArgs: map[string]float64{"mmr": 1299, "level": 3.5},
+	}))
+	require.Equal(t,
+		e.bucket(&pb.SearchFields{DoubleArgs: map[string]float64{"mmr": 1200}}),
+		e.bucket(&pb.SearchFields{DoubleArgs: map[string]float64{"mmr": 1250}}),
+	)
+}
+
+func TestEstimate(t *testing.T) {
+	require.Equal(t, &pb.WaitEstimate{}, estimate(nil, 0))
+	require.Equal(t, &pb.WaitEstimate{
+		Wait:        ptypes.DurationProto(2 * time.Second),
+		SampleCount: 3,
+	}, estimate([]time.Duration{3 * time.Second, time.Second, 2 * time.Second}, 0))
+	require.Equal(t, &pb.WaitEstimate{
+		Wait:        ptypes.DurationProto(time.Second),
+		SampleCount: 3,
+	}, estimate([]time.Duration{3 * time.Second, time.Second, 2 * time.Second}, 1*time.Second))
+	require.Equal(t, &pb.WaitEstimate{}, estimate([]time.Duration{time.Second}, 2*time.Second))
+}
+
+func TestRecordAndEstimate(t *testing.T) {
+	ctx := utilTesting.NewContext(t)
+	cfg := newTestConfig()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	e := New(cfg, store)
+
+	ranked := &pb.SearchFields{StringArgs: map[string]string{"mode": "ranked"}}
+	now := time.Now()
+	tickets := []*pb.Ticket{}
+	for _, wait := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute} {
+		created, err := ptypes.TimestampProto(now.Add(-wait))
+		require.NoError(t, err)
+		tickets = append(tickets, &pb.Ticket{Id: wait.String(), SearchFields: ranked, CreateTime: created})
+	}
+	// Tickets without a create time are not recorded.
+	tickets = append(tickets, &pb.Ticket{Id: "no-create-time", SearchFields: ranked})
+	require.NoError(t, e.Record(ctx, tickets))
+
+	got, err := e.Estimate(ctx, ranked)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), got.SampleCount)
+	wait, err := ptypes.Duration(got.Wait)
+	require.NoError(t, err)
+	require.InDelta(t, float64(2*time.Minute), float64(wait), float64(time.Second))
+
+	created, err := ptypes.TimestampProto(now.Add(-150 * time.Second))
+	require.NoError(t, err)
+	got, err = e.EstimateTicket(ctx, &pb.Ticket{SearchFields: ranked, CreateTime: created})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), got.SampleCount)
+	wait, err = ptypes.Duration(got.Wait)
+	require.NoError(t, err)
+	require.InDelta(t, float64(30*time.Second), float64(wait), float64(time.Second))
+
+	got, err = e.Estimate(ctx, &pb.SearchFields{StringArgs: map[string]string{"mode": "casual"}})
+	require.NoError(t, err)
+	require.Equal(t, &pb.WaitEstimate{}, got)
+}
@@ -0,0 +1,346 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook delivers signed notifications about Ticket events to the
+// webhook sinks configured under webhooks.sinks.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rs/xid"
+	"github.com/sirupsen/logrus"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+var (
+	logger = logrus.WithFields(logrus.Fields{
+		"app":       "openmatch",
+		"component": "webhook",
+	})
+	deadLetterLogger = logrus.WithFields(logrus.Fields{
+		"app":       "openmatch",
+		"component": "webhook.deadletter",
+	})
+)
+
+const (
+	// queueSize is the number of events buffered per sink. Events which do
+	// not fit are dead-lettered immediately rather than blocking the caller.
+	queueSize = 1000
+	// requestTimeout bounds a single delivery attempt.
+	requestTimeout = 10 * time.Second
+	// defaultCloseTimeout bounds how long Close waits for queued events to be
+	// delivered, unless webhooks.closeTimeout is set.
+	defaultCloseTimeout = 10 * time.Second
+)
+
+// Notifier sends WebhookEvents to all configured sinks. Delivery is
+// asynchronous, so Notify never blocks the calling RPC.
+type Notifier struct {
+	sinks        []*sink
+	closeTimeout time.Duration
+}
+
+type sink struct {
+	name   string
+	events map[pb.WebhookEvent_Type]bool
+	secret []byte
+	send   func(context.Context, *pb.NotifyRequest) error
+	close  func()
+	queue  chan *pb.WebhookEvent
+	done   chan struct{}
+	cfg    config.View
+
+	// ctx is canceled once the sink stops delivering events.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mu guards closed, so that events are not sent on the closed queue.
+	mu     sync.RWMutex
+	closed bool
+}
+
+// New creates a Notifier for the sinks configured under webhooks.sinks. Each
+// sink is configured under webhooks.<name>, with the hostname and grpcport or
+// httpport of the receiver, an optional secretPath to sign payloads with, and
+// an optional list of event types to deliver.
+func New(cfg config.View) (*Notifier, error) {
+	n := &Notifier{closeTimeout: defaultCloseTimeout}
+	if cfg.IsSet("webhooks.closeTimeout") {
+		n.closeTimeout = cfg.GetDuration("webhooks.closeTimeout")
+	}
+	for _, name := range cfg.GetStringSlice("webhooks.sinks") {
+		s, err := newSink(cfg, name)
+		if err != nil {
+			n.Close()
+			return nil, err
+		}
+		n.sinks = append(n.sinks, s)
+	}
+	return n, nil
+}
+
+func newSink(cfg config.View, name string) (*sink, error) {
+	prefix := "webhooks." + name
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &sink{
+		name:   name,
+		events: map[pb.WebhookEvent_Type]bool{},
+		queue:  make(chan *pb.WebhookEvent, queueSize),
+		done:   make(chan struct{}),
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	for _, e := range cfg.GetStringSlice(prefix + ".events") {
+		t, ok := pb.WebhookEvent_Type_value[strings.ToUpper(e)]
+		if !ok {
+			return nil, fmt.Errorf("unknown event type %s for webhook sink %s", e, name)
+		}
+		s.events[pb.WebhookEvent_Type(t)] = true
+	}
+
+	if path := cfg.GetString(prefix + ".secretPath"); path != "" {
+		secret, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read secret for webhook sink %s: %w", name, err)
+		}
+		s.secret = bytes.TrimSpace(secret)
+	} else {
+		logger.Warningf("webhook sink %s has no secretPath configured, its payloads will not be signed", name)
+	}
+
+	// grpc is preferred over http.
+	switch {
+	case cfg.IsSet(prefix + ".grpcport"):
+		addr := fmt.Sprintf("%s:%d", cfg.GetString(prefix+".hostname"), cfg.GetInt64(prefix+".grpcport"))
+		conn, err := rpc.GRPCClientFromEndpoint(cfg, addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create grpc client for webhook sink %s: %w", name, err)
+		}
+		client := pb.NewWebhookClient(conn)
+		s.send = func(ctx context.Context, req *pb.NotifyRequest) error {
+			_, err := client.Notify(ctx, req)
+			return err
+		}
+		s.close = func() {
+			if err := conn.Close(); err != nil {
+				logger.WithError(err).Warningf("failed to close grpc client for webhook sink %s", name)
+			}
+		}
+	case cfg.IsSet(prefix + ".httpport"):
+		addr := fmt.Sprintf("%s:%d", cfg.GetString(prefix+".hostname"), cfg.GetInt64(prefix+".httpport"))
+		client, baseURL, err := rpc.HTTPClientFromEndpoint(cfg, addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create http client for webhook sink %s: %w", name, err)
+		}
+		s.send = func(ctx context.Context, req *pb.NotifyRequest) error {
+			return sendHTTP(ctx, client, baseURL, req)
+		}
+		s.close = client.CloseIdleConnections
+	default:
+		return nil, fmt.Errorf("either %s.grpcport or %s.httpport must be specified in the config", prefix, prefix)
+	}
+
+	go s.run()
+	return s, nil
+}
+
+// Notify queues the event for delivery to every sink subscribed to its type.
+// The id and create time of the event are set if missing.
+func (n *Notifier) Notify(event *pb.WebhookEvent) {
+	if n == nil || len(n.sinks) == 0 {
+		return
+	}
+	if event.Id == "" {
+		event.Id = xid.New().String()
+	}
+	if event.CreateTime == nil {
+		event.CreateTime = ptypes.TimestampNow()
+	}
+
+	for _, s := range n.sinks {
+		if len(s.events) > 0 && !s.events[event.Type] {
+			continue
+		}
+		s.enqueue(event)
+	}
+}
+
+func (s *sink) enqueue(event *pb.WebhookEvent) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		s.deadLetter(event, fmt.Errorf("webhook sink is closed"))
+		return
+	}
+	select {
+	case s.queue <- event:
+	default:
+		s.deadLetter(event, fmt.Errorf("queue of %d events is full", queueSize))
+	}
+}
+
+// Close stops all sinks once their queued events have been handled. Events
+// which are not delivered within webhooks.closeTimeout are dead-lettered, as
+// are events notified after Close.
+func (n *Notifier) Close() {
+	if n == nil {
+		return
+	}
+	for _, s := range n.sinks {
+		s.mu.Lock()
+		if !s.closed {
+			s.closed = true
+			close(s.queue)
+		}
+		s.mu.Unlock()
+	}
+
+	timer := time.AfterFunc(n.closeTimeout, func() {
+		for _, s := range n.sinks {
+			s.cancel()
+		}
+	})
+	defer timer.Stop()
+	for _, s := range n.sinks {
+		<-s.done
+		s.cancel()
+		s.close()
+	}
+}
+
+func (s *sink) run() {
+	defer close(s.done)
+	for event := range s.queue {
+		if s.ctx.Err() != nil {
+			s.deadLetter(event, fmt.Errorf("webhook sink was closed before the event was delivered"))
+			continue
+		}
+
+		req, err := s.newRequest(event)
+		if err != nil {
+			s.deadLetter(event, err)
+			continue
+		}
+
+		err = backoff.Retry(func() error {
+			ctx, cancel := context.WithTimeout(s.ctx, requestTimeout)
+			defer cancel()
+			return s.send(ctx, req)
+		}, backoff.WithContext(s.newBackoffStrategy(), s.ctx))
+		if err != nil {
+			s.deadLetter(event, err)
+		}
+	}
+}
+
+func (s *sink) newRequest(event *pb.WebhookEvent) (*pb.NotifyRequest, error) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook event: %w", err)
+	}
+	return &pb.NotifyRequest{
+		Event:     payload,
+		Signature: Sign(s.secret, payload),
+	}, nil
+}
+
+func (s *sink) newBackoffStrategy() backoff.BackOff {
+	backoffStrat := backoff.NewExponentialBackOff()
+	backoffStrat.InitialInterval = s.cfg.GetDuration("backoff.initialInterval")
+	backoffStrat.RandomizationFactor = s.cfg.GetFloat64("backoff.randFactor")
+	backoffStrat.Multiplier = s.cfg.GetFloat64("backoff.multiplier")
+	backoffStrat.MaxInterval = s.cfg.GetDuration("backoff.maxInterval")
+	backoffStrat.MaxElapsedTime = s.cfg.GetDuration("backoff.maxElapsedTime")
+	return backoff.BackOff(backoffStrat)
+}
+
+// deadLetter logs an event which could not be delivered, so that it can be
+// recovered from the logs.
+func (s *sink) deadLetter(event *pb.WebhookEvent, err error) {
+	var m jsonpb.Marshaler
+	payload, mErr := m.MarshalToString(event)
+	if mErr != nil {
+		payload = event.String()
+	}
+	deadLetterLogger.WithFields(logrus.Fields{
+		"sink":     s.name,
+		"event_id": event.GetId(),
+		"event":    payload,
+	}).WithError(err).Error("failed to deliver webhook event")
+}
+
+func sendHTTP(ctx context.Context, client *http.Client, baseURL string, req *pb.NotifyRequest) error {
+	var m jsonpb.Marshaler
+	body, err := m.MarshalToString(req)
+	if err != nil {
+		return backoff.Permanent(fmt.Errorf("failed to marshal notify request: %w", err))
+	}
+
+	httpReq, err := http.NewRequest("POST", baseURL+"/v1/webhook:notify", strings.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(fmt.Errorf("failed to create webhook http request: %w", err))
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(httpReq.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get response from webhook: %w", err)
+	}
+	defer func() {
+		if resp.Body.Close() != nil {
+			logger.Warning("failed to close response body read closer")
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err = fmt.Errorf("webhook returned status %s", resp.Status)
+		// Retrying will not help with client errors, other than being throttled.
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			return backoff.Permanent(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of payload keyed with secret, or an
+// empty string if secret is empty. Receivers can use it to verify NotifyRequests.
+func Sign(secret, payload []byte) string {
+	if len(secret) == 0 {
+		return ""
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
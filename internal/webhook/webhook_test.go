@@ -0,0 +1,228 @@
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+	logrusTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+type receiver struct {
+	mu       sync.Mutex
+	requests []*pb.NotifyRequest
+	statuses []int
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	nr := &pb.NotifyRequest{}
+	if err := jsonpb.Unmarshal(req.Body, nr); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.requests = append(r.requests, nr)
+
+	code := http.StatusOK
+	if len(r.statuses) > 0 {
+		code, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(code)
+}
+
+func newTestConfig(t *testing.T, server *httptest.Server, secret string) *viper.Viper {
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+
+	cfg := viper.New()
+	cfg.Set("webhooks.sinks", []string{"test"})
+	cfg.Set("webhooks.test.hostname", host)
+	cfg.Set("webhooks.test.httpport", port)
+	cfg.Set("backoff.initialInterval", time.Millisecond)
+	cfg.Set("backoff.randFactor", 0.5)
+	cfg.Set("backoff.multiplier", 1.5)
+	cfg.Set("backoff.maxInterval", 10*time.Millisecond)
+	cfg.Set("backoff.maxElapsedTime", 100*time.Millisecond)
+
+	if secret != "" {
+		f, err := ioutil.TempFile("", "webhook-secret")
+		require.NoError(t, err)
+		t.Cleanup(func() { os.Remove(f.Name()) })
+		_, err = f.WriteString(secret + "\n")
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		cfg.Set("webhooks.test.secretPath", f.Name())
+	}
+	return cfg
+}
+
+func TestNotifySignsAndRetries(t *testing.T) {
+	r := &receiver{statuses: []int{http.StatusInternalServerError}}
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	n, err := New(newTestConfig(t, server, "secret"))
+	require.NoError(t, err)
+
+	n.Notify(&pb.WebhookEvent{
+		Type:       pb.WebhookEvent_TICKETS_ASSIGNED,
+		TicketIds:  []string{"1", "2"},
+		Assignment: &pb.Assignment{Connection: "a"},
+	})
+	n.Close()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	require.Len(t, r.requests, 2)
+	require.True(t, proto.Equal(r.requests[0], r.requests[1]))
+
+	req := r.requests[1]
+	require.Equal(t, Sign([]byte("secret"), req.Event), req.Signature)
+
+	event := &pb.WebhookEvent{}
+	require.NoError(t, proto.Unmarshal(req.Event, event))
+	require.NotEmpty(t, event.Id)
+	require.NotNil(t, event.CreateTime)
+	require.Equal(t, pb.WebhookEvent_TICKETS_ASSIGNED, event.Type)
+	require.Equal(t, []string{"1", "2"}, event.TicketIds)
+	require.Equal(t, "a", event.Assignment.GetConnection())
+}
+
+func TestNotifyFiltersEvents(t *testing.T) {
+	r := &receiver{}
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	cfg := newTestConfig(t, server, "")
+	cfg.Set("webhooks.test.events", []string{"TICKET_DELETED"})
+	n, err := New(cfg)
+	require.NoError(t, err)
+
+	n.Notify(&pb.WebhookEvent{Type: pb.WebhookEvent_TICKETS_ASSIGNED, TicketIds: []string{"1"}})
+	n.Notify(&pb.WebhookEvent{Type: pb.WebhookEvent_TICKET_DELETED, TicketIds: []string{"2"}})
+	n.Close()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	require.Len(t, r.requests, 1)
+	require.Empty(t, r.requests[0].Signature)
+
+	event := &pb.WebhookEvent{}
+	require.NoError(t, proto.Unmarshal(r.requests[0].Event, event))
+	require.Equal(t, pb.WebhookEvent_TICKET_DELETED, event.Type)
+}
+
+func TestNotifyDeadLetter(t *testing.T) {
+	hook := logrusTest.NewGlobal()
+	defer hook.Reset()
+
+	r := &receiver{statuses: []int{http.StatusBadRequest}}
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	n, err := New(newTestConfig(t, server, ""))
+	require.NoError(t, err)
+
+	n.Notify(&pb.WebhookEvent{Id: "event-1", Type: pb.WebhookEvent_TICKET_DELETED})
+	n.Close()
+
+	// Client errors are not retried.
+	r.mu.Lock()
+	require.Len(t, r.requests, 1)
+	r.mu.Unlock()
+
+	var deadLetters []*logrus.Entry
+	for _, e := range hook.AllEntries() {
+		if e.Data["component"] == "webhook.deadletter" {
+			deadLetters = append(deadLetters, e)
+		}
+	}
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, "event-1", deadLetters[0].Data["event_id"])
+	require.Equal(t, "test", deadLetters[0].Data["sink"])
+}
+
+func TestCloseDeadLettersUndelivered(t *testing.T) {
+	hook := logrusTest.NewGlobal()
+	defer hook.Reset()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	cfg := newTestConfig(t, server, "")
+	cfg.Set("webhooks.closeTimeout", 50*time.Millisecond)
+	n, err := New(cfg)
+	require.NoError(t, err)
+
+	for _, id := range []string{"event-1", "event-2", "event-3"} {
+		n.Notify(&pb.WebhookEvent{Id: id, Type: pb.WebhookEvent_TICKET_DELETED})
+	}
+	start := time.Now()
+	n.Close()
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+
+	// Events notified after Close are dead-lettered rather than sent on the closed queue.
+	n.Notify(&pb.WebhookEvent{Id: "event-4", Type: pb.WebhookEvent_TICKET_DELETED})
+
+	var ids []string
+	for _, e := range hook.AllEntries() {
+		if e.Data["component"] == "webhook.deadletter" {
+			ids = append(ids, e.Data["event_id"].(string))
+		}
+	}
+	require.ElementsMatch(t, []string{"event-1", "event-2", "event-3", "event-4"}, ids)
+}
+
+func TestNewInvalidConfig(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("webhooks.sinks", []string{"test"})
+	_, err := New(cfg)
+	require.Error(t, err)
+
+	cfg.Set("webhooks.test.httpport", 8080)
+	cfg.Set("webhooks.test.events", []string{"NOT_AN_EVENT"})
+	_, err = New(cfg)
+	require.Error(t, err)
+}
+
+func TestNotifyWithoutSinks(t *testing.T) {
+	n, err := New(viper.New())
+	require.NoError(t, err)
+	n.Notify(&pb.WebhookEvent{Type: pb.WebhookEvent_TICKET_DELETED})
+	n.Close()
+
+	var nilNotifier *Notifier
+	nilNotifier.Notify(&pb.WebhookEvent{Type: pb.WebhookEvent_TICKET_DELETED})
+	nilNotifier.Close()
+}